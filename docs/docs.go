@@ -15,41 +15,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/health": {
+        "/admin/orders": {
             "get": {
-                "description": "서비스의 상태를 확인하고 정상 동작 여부를 검증합니다.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "health"
-                ],
-                "summary": "서비스 상태 확인",
-                "responses": {
-                    "200": {
-                        "description": "서비스 상태",
-                        "schema": {
-                            "$ref": "#/definitions/usecases.HealthStatus"
-                        }
-                    },
-                    "500": {
-                        "description": "서버 오류",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
+                "security": [
+                    {
+                        "Bearer": []
                     }
-                }
-            }
-        },
-        "/login": {
-            "post": {
-                "description": "사용자 인증 정보를 확인하고 JWT 토큰을 반환합니다.",
+                ],
+                "description": "전체 회원의 주문을 조건으로 검색합니다. (관리자 전용)",
                 "consumes": [
                     "application/json"
                 ],
@@ -57,25 +30,70 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "사용자 로그인",
+                "summary": "주문 검색",
                 "parameters": [
                     {
-                        "description": "사용자 로그인 정보",
-                        "name": "loginRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.LoginRequest"
-                        }
+                        "type": "string",
+                        "description": "주문번호",
+                        "name": "order_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "회원번호",
+                        "name": "member_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "상품번호",
+                        "name": "product_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "주문 상태 (쉼표로 구분)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "조회 시작일 (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "조회 종료일 (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "정렬 순서 (latest, oldest, amount_desc, amount_asc)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 번호 (기본 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 크기 (기본 20, 최대 100)",
+                        "name": "size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "로그인 성공",
+                        "description": "주문 목록",
                         "schema": {
-                            "$ref": "#/definitions/response.LoginResponse"
+                            "$ref": "#/definitions/response.OrderPageResponse"
                         }
                     },
                     "400": {
@@ -87,8 +105,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "401": {
-                        "description": "인증 실패",
+                    "403": {
+                        "description": "권한 없음",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -97,7 +115,7 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "서버 오류",
+                        "description": "주문 검색 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -108,14 +126,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/members": {
+        "/admin/orders/{order_number}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "모든 회원의 목록을 조회합니다. (관리자 전용)",
+                "description": "주문번호로 주문 상세 정보를 조회합니다. (관리자 전용)",
                 "consumes": [
                     "application/json"
                 ],
@@ -123,17 +141,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "members"
+                    "admin"
+                ],
+                "summary": "주문번호로 주문 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "주문번호",
+                        "name": "order_number",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "회원 목록 조회",
                 "responses": {
                     "200": {
-                        "description": "회원 목록",
+                        "description": "주문 상세",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.MemberResponse"
-                            }
+                            "$ref": "#/definitions/response.OrderResponse"
                         }
                     },
                     "403": {
@@ -146,7 +170,7 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "목록 조회 실패",
+                        "description": "주문 조회 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -155,9 +179,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "새로운 회원을 등록합니다.",
+            }
+        },
+        "/admin/orders/{order_number}/cancel": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "취소 사유 코드와 상세 사유를 남기고 주문을 취소합니다. (관리자 전용)",
                 "consumes": [
                     "application/json"
                 ],
@@ -165,25 +196,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "members"
+                    "admin"
                 ],
-                "summary": "회원 가입",
+                "summary": "관리자 주문 취소",
                 "parameters": [
                     {
-                        "description": "회원 가입 정보",
-                        "name": "CreateMemberRequest",
+                        "type": "string",
+                        "description": "멱등 키",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "주문번호",
+                        "name": "order_number",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "취소 사유 (customer_request, fraud, out_of_stock, undeliverable, payment_issue, other)",
+                        "name": "cancelRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateMemberRequest"
+                            "$ref": "#/definitions/request.AdminCancelOrderRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "가입 성공",
+                    "200": {
+                        "description": "취소 성공",
                         "schema": {
-                            "$ref": "#/definitions/response.MemberResponse"
+                            "$ref": "#/definitions/response.OrderResponse"
                         }
                     },
                     "400": {
@@ -195,8 +239,17 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "500": {
-                        "description": "서버 오류",
+                        "description": "취소 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -207,14 +260,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/members/me": {
+        "/cart": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "인증된 사용자의 정보를 조회합니다.",
+                "description": "인증된 사용자의 장바구니를 현재 상품 가격과 재고 기준으로 조회합니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -222,18 +275,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "members"
+                    "cart"
                 ],
-                "summary": "내 정보 조회",
+                "summary": "내 장바구니 조회",
                 "responses": {
                     "200": {
-                        "description": "내 정보",
+                        "description": "장바구니",
                         "schema": {
-                            "$ref": "#/definitions/response.MemberResponse"
+                            "$ref": "#/definitions/response.CartResponse"
                         }
                     },
                     "500": {
-                        "description": "정보 조회 실패",
+                        "description": "장바구니 조회 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -242,14 +295,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/cart/checkout": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "인증된 사용자의 정보를 수정합니다.",
+                "description": "장바구니에 담긴 상품으로 주문을 생성하고 장바구니를 비웁니다. 배송지를 지정하지 않으면 기본 배송지를 사용합니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -257,28 +312,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "members"
+                    "cart"
                 ],
-                "summary": "내 정보 수정",
+                "summary": "장바구니 주문",
                 "parameters": [
                     {
-                        "description": "수정할 정보",
-                        "name": "updateRequest",
+                        "description": "배송지 및 쿠폰",
+                        "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateMemberRequest"
+                            "$ref": "#/definitions/request.CheckoutCartRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "수정 성공",
+                    "201": {
+                        "description": "주문 생성 성공",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/response.CreateOrderResponse"
                         }
                     },
                     "400": {
@@ -291,7 +342,7 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "수정 실패",
+                        "description": "주문 생성 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -300,14 +351,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/cart/items": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "인증된 사용자의 계정을 삭제합니다.",
+                "description": "장바구니에 상품을 추가합니다. 이미 담긴 상품이면 수량을 더합니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -315,12 +368,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "members"
+                    "cart"
+                ],
+                "summary": "장바구니 상품 추가",
+                "parameters": [
+                    {
+                        "description": "장바구니 상품 정보",
+                        "name": "cartItemRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AddCartItemRequest"
+                        }
+                    }
                 ],
-                "summary": "회원 탈퇴",
                 "responses": {
                     "200": {
-                        "description": "삭제 성공",
+                        "description": "추가 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.CartResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -329,7 +399,7 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "삭제 실패",
+                        "description": "추가 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -340,14 +410,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/members/stats": {
-            "get": {
+        "/cart/items/{product_number}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "특정 월의 회원 가입 통계를 조회합니다. (관리자 전용)",
+                "description": "장바구니에 담긴 상품의 수량을 수정합니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -355,23 +425,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "members"
+                    "cart"
                 ],
-                "summary": "회원 통계 조회",
+                "summary": "장바구니 상품 수량 수정",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "조회할 월 (YYYY-MM)",
-                        "name": "month",
-                        "in": "query",
+                        "description": "상품번호",
+                        "name": "product_number",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "수정할 수량",
+                        "name": "cartItemRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateCartItemRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "통계 정보",
+                        "description": "수정 성공",
                         "schema": {
-                            "$ref": "#/definitions/response.MemberStatsResponse"
+                            "$ref": "#/definitions/response.CartResponse"
                         }
                     },
                     "400": {
@@ -383,17 +462,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "403": {
-                        "description": "권한 없음",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
                     "500": {
-                        "description": "통계 조회 실패",
+                        "description": "수정 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -402,16 +472,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/orders": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "새로운 주문을 생성합니다.",
+                "description": "장바구니에서 상품을 삭제합니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -419,38 +487,62 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "cart"
                 ],
-                "summary": "주문 생성",
+                "summary": "장바구니 상품 삭제",
                 "parameters": [
                     {
-                        "description": "주문 정보",
-                        "name": "orderRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CreateOrderRequest"
-                        }
+                        "type": "string",
+                        "description": "상품번호",
+                        "name": "product_number",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "주문 생성 성공",
+                    "200": {
+                        "description": "삭제 성공",
                         "schema": {
-                            "$ref": "#/definitions/response.OrderResponse"
+                            "$ref": "#/definitions/response.CartResponse"
                         }
                     },
-                    "400": {
-                        "description": "잘못된 요청",
+                    "500": {
+                        "description": "삭제 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "description": "전체 카테고리를 정렬 순서에 따라 상하위 트리로 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "카테고리 트리 조회",
+                "responses": {
+                    "200": {
+                        "description": "카테고리 트리",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.CategoryResponse"
+                            }
+                        }
                     },
                     "500": {
-                        "description": "주문 생성 실패",
+                        "description": "조회 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -459,16 +551,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/orders/me": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "인증된 사용자의 주문 내역을 조회합니다.",
+                "description": "새로운 카테고리를 등록합니다. 상위 카테고리를 지정하면 하위 카테고리로 등록됩니다. (관리자 전용)",
                 "consumes": [
                     "application/json"
                 ],
@@ -476,21 +566,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "categories"
+                ],
+                "summary": "카테고리 생성",
+                "parameters": [
+                    {
+                        "description": "카테고리 정보",
+                        "name": "categoryRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateCategoryRequest"
+                        }
+                    }
                 ],
-                "summary": "내 주문 조회",
                 "responses": {
-                    "200": {
-                        "description": "주문 목록",
+                    "201": {
+                        "description": "생성 성공",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.OrderResponse"
+                            "$ref": "#/definitions/response.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
                     "500": {
-                        "description": "주문 조회 실패",
+                        "description": "생성 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -501,14 +617,62 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders/stats": {
+        "/categories/{id}": {
             "get": {
+                "description": "카테고리 정보를 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "카테고리 상세 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "카테고리 정보",
+                        "schema": {
+                            "$ref": "#/definitions/response.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "카테고리 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "특정 월의 주문 통계를 조회합니다. (관리자 전용)",
+                "description": "카테고리명, 상위 카테고리, 정렬 순서를 수정합니다. 슬러그는 변경할 수 없습니다. (관리자 전용)",
                 "consumes": [
                     "application/json"
                 ],
@@ -516,23 +680,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "categories"
                 ],
-                "summary": "주문 통계 조회",
+                "summary": "카테고리 수정",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "조회할 월 (YYYY-MM)",
-                        "name": "month",
-                        "in": "query",
+                        "type": "integer",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "수정할 카테고리 정보",
+                        "name": "categoryRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateCategoryRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "통계 정보",
+                        "description": "수정 성공",
                         "schema": {
-                            "$ref": "#/definitions/response.OrderStatsResponse"
+                            "$ref": "#/definitions/response.CategoryResponse"
                         }
                     },
                     "400": {
@@ -554,7 +727,7 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "통계 조회 실패",
+                        "description": "수정 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -563,16 +736,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/orders/{id}/cancel": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "특정 주문을 취소합니다.",
+                "description": "하위 카테고리와 등록된 상품이 없는 카테고리를 삭제합니다. (관리자 전용)",
                 "consumes": [
                     "application/json"
                 ],
@@ -580,12 +751,12 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "categories"
                 ],
-                "summary": "주문 취소",
+                "summary": "카테고리 삭제",
                 "parameters": [
                     {
-                        "type": "string",
+                        "type": "integer",
                         "description": "기본키 (primary key)",
                         "name": "id",
                         "in": "path",
@@ -594,7 +765,25 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "취소 성공",
+                        "description": "삭제 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -603,7 +792,7 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "취소 실패",
+                        "description": "삭제 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -614,9 +803,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/products": {
+        "/coupons": {
             "get": {
-                "description": "상품 목록을 필터링하여 조회합니다.",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "전체 쿠폰 목록을 조회합니다. (관리자 전용)",
                 "consumes": [
                     "application/json"
                 ],
@@ -624,30 +818,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "products"
-                ],
-                "summary": "상품 목록 조회",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "카테고리",
-                        "name": "category",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "상품명",
-                        "name": "product_name",
-                        "in": "query"
-                    }
+                    "coupons"
                 ],
+                "summary": "쿠폰 목록 조회",
                 "responses": {
                     "200": {
-                        "description": "상품 목록",
+                        "description": "쿠폰 목록",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/response.ProductResponse"
+                                "$ref": "#/definitions/response.CouponResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
@@ -668,7 +857,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "새로운 상품을 등록합니다. (관리자 전용)",
+                "description": "새로운 쿠폰을 등록합니다. (관리자 전용)",
                 "consumes": [
                     "application/json"
                 ],
@@ -676,17 +865,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "coupons"
                 ],
-                "summary": "상품 생성",
+                "summary": "쿠폰 생성",
                 "parameters": [
                     {
-                        "description": "상품 정보",
-                        "name": "productRequest",
+                        "description": "쿠폰 정보",
+                        "name": "couponRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateProductRequest"
+                            "$ref": "#/definitions/request.CreateCouponRequest"
                         }
                     }
                 ],
@@ -694,7 +883,7 @@ const docTemplate = `{
                     "201": {
                         "description": "생성 성공",
                         "schema": {
-                            "$ref": "#/definitions/response.ProductResponse"
+                            "$ref": "#/definitions/response.CouponResponse"
                         }
                     },
                     "400": {
@@ -727,14 +916,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/products/{id}": {
-            "delete": {
+        "/coupons/{id}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "상품을 삭제합니다. (관리자 전용)",
+                "description": "쿠폰 정보를 조회합니다. (관리자 전용)",
                 "consumes": [
                     "application/json"
                 ],
@@ -742,9 +931,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "coupons"
                 ],
-                "summary": "상품 삭제",
+                "summary": "쿠폰 상세 조회",
                 "parameters": [
                     {
                         "type": "integer",
@@ -756,16 +945,13 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "삭제 성공",
+                        "description": "쿠폰 정보",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/response.CouponResponse"
                         }
                     },
                     "400": {
-                        "description": "잘못된 상품 번호",
+                        "description": "잘못된 요청",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -782,8 +968,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
-                        "description": "삭제 실패",
+                    "404": {
+                        "description": "쿠폰 없음",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -792,16 +978,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/products/{id}/stock": {
+            },
             "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "상품의 재고 수량을 수정합니다. (관리자 전용)",
+                "description": "쿠폰 정보를 수정합니다. 쿠폰 코드는 변경할 수 없습니다. (관리자 전용)",
                 "consumes": [
                     "application/json"
                 ],
@@ -809,9 +993,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "coupons"
                 ],
-                "summary": "재고 수정",
+                "summary": "쿠폰 수정",
                 "parameters": [
                     {
                         "type": "integer",
@@ -821,12 +1005,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "재고 정보",
-                        "name": "stockRequest",
+                        "description": "수정할 쿠폰 정보",
+                        "name": "couponRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateStockRequest"
+                            "$ref": "#/definitions/request.UpdateCouponRequest"
                         }
                     }
                 ],
@@ -834,10 +1018,7 @@ const docTemplate = `{
                     "200": {
                         "description": "수정 성공",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/response.CouponResponse"
                         }
                     },
                     "400": {
@@ -868,192 +1049,4246 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "request.CreateMemberRequest": {
-            "type": "object",
-            "properties": {
-                "account_id": {
-                    "type": "string",
-                    "example": "hong43ok"
-                },
-                "email": {
-                    "type": "string",
-                    "example": "hong43ok@gmail.com"
-                },
-                "is_admin": {
-                    "type": "boolean",
-                    "example": true
-                },
-                "is_withdrawn": {
-                    "type": "boolean",
-                    "example": false
-                },
-                "nick_name": {
-                    "type": "string",
-                    "example": "hongmang"
-                },
-                "password": {
-                    "type": "string",
-                    "example": "ghdwjddhks"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "사용 이력이 없는 쿠폰을 삭제합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "coupons"
+                ],
+                "summary": "쿠폰 삭제",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "삭제 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "삭제 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "서비스의 상태를 확인하고 정상 동작 여부를 검증합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "서비스 상태 확인",
+                "responses": {
+                    "200": {
+                        "description": "서비스 상태",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.HealthStatus"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "사용자 인증 정보를 확인하고 JWT 토큰을 반환합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "사용자 로그인",
+                "parameters": [
+                    {
+                        "description": "사용자 로그인 정보",
+                        "name": "loginRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "로그인 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/members": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "모든 회원의 목록을 조회합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "회원 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "회원 목록",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.MemberResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "목록 조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "새로운 회원을 등록합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "회원 가입",
+                "parameters": [
+                    {
+                        "description": "회원 가입 정보",
+                        "name": "CreateMemberRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "가입 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.MemberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/members/me": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "인증된 사용자의 정보를 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "내 정보 조회",
+                "responses": {
+                    "200": {
+                        "description": "내 정보",
+                        "schema": {
+                            "$ref": "#/definitions/response.MemberResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "정보 조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "인증된 사용자의 정보를 수정합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "내 정보 수정",
+                "parameters": [
+                    {
+                        "description": "수정할 정보",
+                        "name": "updateRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "수정 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "수정 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "인증된 사용자의 계정을 삭제합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "회원 탈퇴",
+                "responses": {
+                    "200": {
+                        "description": "삭제 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "삭제 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/members/me/addresses": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "인증된 사용자의 배송지 목록을 조회합니다. 기본 배송지가 먼저 표시됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "addresses"
+                ],
+                "summary": "내 배송지 조회",
+                "responses": {
+                    "200": {
+                        "description": "배송지 목록",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.AddressResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "배송지 조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "인증된 사용자의 배송지를 등록합니다. 첫 번째 배송지는 기본 배송지로 지정됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "addresses"
+                ],
+                "summary": "배송지 등록",
+                "parameters": [
+                    {
+                        "description": "배송지 정보",
+                        "name": "addressRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AddressRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "등록 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.AddressResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "등록 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/members/me/addresses/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "인증된 사용자의 배송지를 수정합니다. 이미 생성된 주문의 배송지는 변경되지 않습니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "addresses"
+                ],
+                "summary": "배송지 수정",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "배송지 기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "수정할 배송지 정보",
+                        "name": "addressRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AddressRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "수정 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.AddressResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "수정 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "인증된 사용자의 배송지를 삭제합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "addresses"
+                ],
+                "summary": "배송지 삭제",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "배송지 기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "삭제 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "삭제 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/members/stats": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "특정 월의 회원 가입 통계를 조회합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "회원 통계 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "조회할 월 (YYYY-MM)",
+                        "name": "month",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "통계 정보",
+                        "schema": {
+                            "$ref": "#/definitions/response.MemberStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "통계 조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "새로운 주문을 생성합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "주문 생성",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "멱등 키",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "주문 정보",
+                        "name": "orderRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "주문 생성 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "주문 생성 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/me": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "인증된 사용자의 주문 내역을 배열로 조회합니다. 한 번에 최대 100건까지 응답하며, 다음 페이지가 있으면 X-Next-Cursor 헤더로 커서를 전달합니다. 신규 연동은 /v2/orders/me 를 사용합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "내 주문 조회 (배열 응답)",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "주문 상태 (쉼표로 구분)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "조회 시작일 (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "조회 종료일 (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "정렬 순서 (latest, oldest)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "이전 응답의 X-Next-Cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 크기 (기본 100, 최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "주문 목록",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.OrderResponse"
+                            }
+                        },
+                        "headers": {
+                            "X-Next-Cursor": {
+                                "type": "integer",
+                                "description": "다음 페이지 커서 (마지막 페이지면 생략)"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "주문 조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/quote": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "주문을 생성하지 않고 상품 금액, 할인, 세금, 배송비가 포함된 결제 예정 금액을 계산합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "주문 금액 조회",
+                "parameters": [
+                    {
+                        "description": "주문 정보",
+                        "name": "orderRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "금액 계산 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.PriceBreakdownResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "금액 계산 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/stats": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "특정 월의 주문 통계를 조회합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "주문 통계 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "조회할 월 (YYYY-MM)",
+                        "name": "month",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "통계 정보",
+                        "schema": {
+                            "$ref": "#/definitions/response.OrderStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "통계 조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "주문 상세 정보를 조회합니다. (주문자 또는 관리자)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "주문 상세 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "주문 상세",
+                        "schema": {
+                            "$ref": "#/definitions/response.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "주문 조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/cancel": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "특정 주문을 취소합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "주문 취소",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "멱등 키",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "취소 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "취소 정책에 의해 거절",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "취소 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/partial-cancel": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "주문 상품의 일부 수량을 취소하고 취소 금액을 환불합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "주문 부분 취소",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "멱등 키",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "취소할 상품과 수량",
+                        "name": "cancelRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.PartialCancelOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "부분 취소 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "취소 정책에 의해 거절",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "부분 취소 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/returns": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "배송 완료된 주문의 상품 반품을 요청합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "returns"
+                ],
+                "summary": "반품 요청",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "멱등 키",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "주문 기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "반품 정보",
+                        "name": "returnRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateReturnRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "반품 요청 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.ReturnResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "반품 요청 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/shipments": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "인증된 사용자의 주문에 등록된 배송 목록을 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "주문 배송 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "주문 기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "배송 목록",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.ShipmentResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "배송 조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "택배사에 운송장을 등록하고 주문을 배송 중으로 변경합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "배송 등록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "주문 기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "등록 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.ShipmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "등록 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/status": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "주문을 결제 완료, 배송 중, 배송 완료 상태로 변경합니다. 결제 대기 주문을 결제 완료나 배송 중으로 변경하면 승인된 결제를 매입합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "주문 상태 변경",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "변경할 주문 상태 (paid, shipped, delivered)",
+                        "name": "statusRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateOrderStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "변경 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "변경 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products": {
+            "get": {
+                "description": "상품을 검색어, 카테고리, 가격, 재고 조건으로 검색하고 커서 기반으로 페이지 단위 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "상품 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "검색어 (상품명, 상품 설명)",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "카테고리 슬러그 (쉼표로 구분, 하위 카테고리 포함)",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "최소 가격",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "최대 가격",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "재고가 있는 상품만 조회",
+                        "name": "in_stock",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "정렬 순서 (newest, price_asc, price_desc, relevance / 기본: 검색어가 있으면 relevance, 없으면 newest)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "이전 페이지의 next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 크기 (기본 20, 최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "상품 목록",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProductListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "새로운 상품을 등록합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "상품 생성",
+                "parameters": [
+                    {
+                        "description": "상품 정보",
+                        "name": "productRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "생성 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "생성 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "주문 이력이 없는 상품을 삭제합니다. 주문 이력이 있으면 보관 처리를 이용해야 합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "상품 삭제",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "삭제 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 상품 번호",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "삭제 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "상품명, 카테고리, 설명, 가격, 무게와 크기 중 지정한 항목만 수정합니다. 가격이 바뀌면 가격 변경 이력이 기록됩니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "상품 정보 수정",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "수정할 항목",
+                        "name": "updateRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "수정 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "수정 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/archive": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "상품을 판매 중단 상태로 보관합니다. 목록과 주문 대상에서 제외되며, 지난 주문에서는 계속 조회됩니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "상품 보관 처리",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "보관 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "보관 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/price-history": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "상품의 가격 변경 이력을 오래된 순으로 조회합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "가격 변경 이력 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "가격 변경 이력",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.ProductPriceChangeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "보관 처리된 상품을 다시 판매 상태로 되돌립니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "상품 보관 해제",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "해제 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "해제 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/stock": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "상품의 재고 수량을 수정합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "재고 수정",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "재고 정보",
+                        "name": "stockRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateStockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "수정 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "재고 수량 충돌",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "수정 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/stock-movements": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "상품의 재고 변동 이력을 오래된 순으로 조회합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "재고 변동 이력 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "재고 변동 이력",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.StockMovementResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/stock/adjust": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "상품 재고를 현재 수량 기준으로 증감합니다. 결과 재고가 음수가 되면 거절됩니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "재고 증감 조정",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "증감 수량과 사유",
+                        "name": "adjustRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AdjustStockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "조정 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.StockMovementResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "재고 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "조정 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/variants": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "옵션이 정의된 상위 상품에 옵션 값 조합별 옵션 상품을 추가합니다. 가격을 지정하지 않으면 상위 상품 가격을 사용합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "옵션 상품 추가",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "상위 상품 기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "옵션 상품 정보",
+                        "name": "variantRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AddVariantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "추가 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "추가 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/returns": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "전체 반품 요청 목록을 조회합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "returns"
+                ],
+                "summary": "반품 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "반품 상태 (requested, approved, received, refunded, rejected)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "반품 목록",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.ReturnResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "반품 조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/returns/me": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "인증된 사용자의 반품 요청 목록을 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "returns"
+                ],
+                "summary": "내 반품 조회",
+                "responses": {
+                    "200": {
+                        "description": "반품 목록",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.ReturnResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "반품 조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/returns/{id}/status": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "반품을 승인, 거절, 입고, 환불 상태로 변경합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "returns"
+                ],
+                "summary": "반품 상태 변경",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "반품 기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "변경할 반품 상태 (approved, rejected, received, refunded)",
+                        "name": "statusRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateReturnStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "변경 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.ReturnResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "변경 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/shipments/pending": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "결제 승인 또는 결제 완료 후 아직 배송이 등록되지 않은 주문 목록을 조회합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "배송 대기 주문 조회",
+                "responses": {
+                    "200": {
+                        "description": "주문 목록",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.OrderResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "주문 조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/shipments/{id}/deliver": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "배송을 완료 처리합니다. 주문의 모든 배송이 완료되면 주문도 배송 완료로 변경됩니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "배송 완료 처리",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "배송 기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "처리 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.ShipmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "처리 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/shipments/{id}/tracking": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "택배사에서 배송 상태를 조회해 배송 완료 여부를 반영합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "배송 추적 갱신",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "배송 기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "갱신 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.ShipmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "갱신 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tax-classes": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "전체 과세 분류 목록을 조회합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tax-classes"
+                ],
+                "summary": "과세 분류 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "과세 분류 목록",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.TaxClassResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "새로운 과세 분류를 등록합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tax-classes"
+                ],
+                "summary": "과세 분류 생성",
+                "parameters": [
+                    {
+                        "description": "과세 분류 정보",
+                        "name": "taxClassRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateTaxClassRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "생성 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.TaxClassResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "생성 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tax-classes/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "과세 분류 정보를 조회합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tax-classes"
+                ],
+                "summary": "과세 분류 상세 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "과세 분류 정보",
+                        "schema": {
+                            "$ref": "#/definitions/response.TaxClassResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "과세 분류 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "과세 분류 정보를 수정합니다. 과세 분류명은 변경할 수 없습니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tax-classes"
+                ],
+                "summary": "과세 분류 수정",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "수정할 과세 분류 정보",
+                        "name": "taxClassRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateTaxClassRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "수정 성공",
+                        "schema": {
+                            "$ref": "#/definitions/response.TaxClassResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "수정 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "과세 분류를 삭제합니다. (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tax-classes"
+                ],
+                "summary": "과세 분류 삭제",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "기본키 (primary key)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "삭제 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "삭제 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/orders/me": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "인증된 사용자의 주문 내역을 커서 기반으로 페이지 단위 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "내 주문 조회 (페이지네이션)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "주문 상태 (쉼표로 구분)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "조회 시작일 (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "조회 종료일 (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "정렬 순서 (latest, oldest)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "이전 페이지의 next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 크기 (기본 20, 최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "주문 목록",
+                        "schema": {
+                            "$ref": "#/definitions/response.OrderListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "주문 조회 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "request.AddCartItemRequest": {
+            "type": "object",
+            "properties": {
+                "product_number": {
+                    "type": "string",
+                    "example": "Product0fe0dfb2-0a9e-4e47-b670-5d1a761e62b5"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "request.AddVariantRequest": {
+            "type": "object",
+            "properties": {
+                "option_values": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "price": {
+                    "description": "지정하지 않으면 상위 상품 가격으로 판매",
+                    "type": "integer",
+                    "example": 12000
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "TSHIRT-RED-M"
+                },
+                "stock_quantity": {
+                    "type": "integer",
+                    "example": 30
+                }
+            }
+        },
+        "request.AddressRequest": {
+            "type": "object",
+            "properties": {
+                "base_address": {
+                    "type": "string",
+                    "example": "서울특별시 강남구 테헤란로 123"
+                },
+                "detail_address": {
+                    "type": "string",
+                    "example": "4층"
+                },
+                "is_default": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "label": {
+                    "type": "string",
+                    "example": "집"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "010-1234-5678"
+                },
+                "recipient_name": {
+                    "type": "string",
+                    "example": "홍길동"
+                },
+                "zip_code": {
+                    "type": "string",
+                    "example": "06236"
+                }
+            }
+        },
+        "request.AdjustStockRequest": {
+            "type": "object",
+            "properties": {
+                "delta": {
+                    "type": "integer",
+                    "example": -3
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "파손 상품 폐기"
+                }
+            }
+        },
+        "request.AdminCancelOrderRequest": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "example": "도용된 카드로 결제된 주문입니다."
+                },
+                "reason_code": {
+                    "type": "string",
+                    "example": "fraud"
+                }
+            }
+        },
+        "request.CheckoutCartRequest": {
+            "type": "object",
+            "properties": {
+                "address_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "coupon_code": {
+                    "type": "string",
+                    "example": "WELCOME10"
+                }
+            }
+        },
+        "request.CreateCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "과자"
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "snacks"
+                },
+                "sort_order": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "request.CreateCouponRequest": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "code": {
+                    "type": "string",
+                    "example": "WELCOME10"
+                },
+                "discount_type": {
+                    "type": "string",
+                    "example": "percentage"
+                },
+                "discount_value": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2024-10-01T00:00:00Z"
+                },
+                "max_discount_amount": {
+                    "type": "integer",
+                    "example": 5000
+                },
+                "min_order_amount": {
+                    "type": "integer",
+                    "example": 10000
+                },
+                "name": {
+                    "type": "string",
+                    "example": "신규 회원 10% 할인"
+                },
+                "per_member_limit": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2024-09-01T00:00:00Z"
+                },
+                "usage_limit": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "request.CreateMemberRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "hong43ok"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "hong43ok@gmail.com"
+                },
+                "is_admin": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "is_withdrawn": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "nick_name": {
+                    "type": "string",
+                    "example": "hongmang"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "ghdwjddhks"
+                }
+            }
+        },
+        "request.CreateOrderItemRequest": {
+            "type": "object",
+            "properties": {
+                "product_number": {
+                    "type": "string",
+                    "example": "Product0fe0dfb2-0a9e-4e47-b670-5d1a761e62b5"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "request.CreateOrderRequest": {
+            "type": "object",
+            "properties": {
+                "address_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "coupon_code": {
+                    "type": "string",
+                    "example": "WELCOME10"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/request.CreateOrderItemRequest"
+                    }
+                }
+            }
+        },
+        "request.CreateProductRequest": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "description": {
+                    "type": "string",
+                    "example": "치즈가 듬뿍 들어간 피자"
+                },
+                "height": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "length": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "options": {
+                    "description": "옵션을 지정하면 재고 없이 상위 상품으로 등록되며, 옵션 상품을 추가해 판매",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/request.ProductOptionRequest"
+                    }
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "product_name": {
+                    "type": "string",
+                    "example": "pizza"
+                },
+                "stock_quantity": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "weight": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "width": {
+                    "type": "integer",
+                    "example": 30
+                }
+            }
+        },
+        "request.CreateReturnRequest": {
+            "type": "object",
+            "properties": {
+                "product_number": {
+                    "type": "string",
+                    "example": "Product0fe0dfb2-0a9e-4e47-b670-5d1a761e62b5"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "상품이 파손되어 도착했습니다."
+                }
+            }
+        },
+        "request.CreateTaxClassRequest": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "is_default": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "식품 경감세율"
+                },
+                "price_mode": {
+                    "type": "string",
+                    "example": "inclusive"
+                },
+                "rate": {
+                    "type": "integer",
+                    "example": 800
+                }
+            }
+        },
+        "request.LoginRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "hong43ok"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "ghdwjddhks"
+                }
+            }
+        },
+        "request.PartialCancelOrderRequest": {
+            "type": "object",
+            "properties": {
+                "product_number": {
+                    "type": "string",
+                    "example": "Product0fe0dfb2-0a9e-4e47-b670-5d1a761e62b5"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "request.ProductOptionRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "size"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "S",
+                        "M",
+                        "L"
+                    ]
+                }
+            }
+        },
+        "request.UpdateCartItemRequest": {
+            "type": "object",
+            "properties": {
+                "quantity": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "request.UpdateCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "스낵"
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "sort_order": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "request.UpdateCouponRequest": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "discount_type": {
+                    "type": "string",
+                    "example": "percentage"
+                },
+                "discount_value": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2024-10-01T00:00:00Z"
+                },
+                "max_discount_amount": {
+                    "type": "integer",
+                    "example": 5000
+                },
+                "min_order_amount": {
+                    "type": "integer",
+                    "example": 10000
+                },
+                "name": {
+                    "type": "string",
+                    "example": "신규 회원 10% 할인"
+                },
+                "per_member_limit": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2024-09-01T00:00:00Z"
+                },
+                "usage_limit": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "request.UpdateMemberRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "hong43ok@naver.com"
+                },
+                "nick_name": {
+                    "type": "string",
+                    "example": "hong"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "hong"
+                }
+            }
+        },
+        "request.UpdateOrderStatusRequest": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "example": "shipped"
+                }
+            }
+        },
+        "request.UpdateProductRequest": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "description": {
+                    "type": "string",
+                    "example": "치즈가 듬뿍 들어간 피자"
+                },
+                "height": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "length": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 1200
+                },
+                "product_name": {
+                    "type": "string",
+                    "example": "cheese pizza"
+                },
+                "weight": {
+                    "type": "integer",
+                    "example": 550
+                },
+                "width": {
+                    "type": "integer",
+                    "example": 30
+                }
+            }
+        },
+        "request.UpdateReturnStatusRequest": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "example": "approved"
+                }
+            }
+        },
+        "request.UpdateStockRequest": {
+            "type": "object",
+            "properties": {
+                "expected_stock_quantity": {
+                    "description": "지정하면 현재 재고가 이 값과 같을 때만 수정",
+                    "type": "integer",
+                    "example": 70
+                },
+                "stock_quantity": {
+                    "type": "integer",
+                    "example": 77
+                }
+            }
+        },
+        "request.UpdateTaxClassRequest": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "is_default": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "price_mode": {
+                    "type": "string",
+                    "example": "exclusive"
+                },
+                "rate": {
+                    "type": "integer",
+                    "example": 1000
+                }
+            }
+        },
+        "response.AddressResponse": {
+            "type": "object",
+            "properties": {
+                "base_address": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "detail_address": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_default": {
+                    "type": "boolean"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "recipient_name": {
+                    "type": "string"
+                },
+                "zip_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.CancelReasonStatResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.CartItemResponse": {
+            "type": "object",
+            "properties": {
+                "is_available": {
+                    "type": "boolean"
+                },
+                "line_total": {
+                    "type": "integer"
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "product_name": {
+                    "type": "string"
+                },
+                "product_number": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "stock_quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.CartResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.CartItemResponse"
+                    }
+                },
+                "member_number": {
+                    "type": "string"
+                },
+                "total_amount": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.CategoryResponse"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.CouponResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "code": {
+                    "type": "string"
+                },
+                "discount_type": {
+                    "type": "string"
+                },
+                "discount_value": {
+                    "type": "integer"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "max_discount_amount": {
+                    "type": "integer"
+                },
+                "min_order_amount": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "per_member_limit": {
+                    "type": "integer"
+                },
+                "product_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "usage_limit": {
+                    "type": "integer"
+                },
+                "used_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.CreateOrderResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "order": {
+                    "$ref": "#/definitions/response.OrderResponse"
+                }
+            }
+        },
+        "response.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.MemberResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_admin": {
+                    "type": "boolean"
+                },
+                "is_withdrawn": {
+                    "type": "boolean"
+                },
+                "member_number": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "withdrawn_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.MemberStatsResponse": {
+            "type": "object",
+            "properties": {
+                "deleted_members": {
+                    "type": "integer"
+                },
+                "joined_members": {
+                    "type": "integer"
+                },
+                "month": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.OrderCancellationResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "canceled_at": {
+                    "type": "string"
+                },
+                "product_number": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.OrderItemResponse": {
+            "type": "object",
+            "properties": {
+                "canceled_quantity": {
+                    "type": "integer"
+                },
+                "discount_amount": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "line_total": {
+                    "type": "integer"
+                },
+                "option_values": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "product_name": {
+                    "type": "string"
+                },
+                "product_number": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "sku": {
+                    "type": "string"
+                },
+                "tax_amount": {
+                    "type": "integer"
+                },
+                "tax_inclusive": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "response.OrderListResponse": {
+            "type": "object",
+            "properties": {
+                "has_next": {
+                    "type": "boolean"
+                },
+                "next_cursor": {
+                    "type": "integer"
+                },
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.OrderResponse"
+                    }
+                }
+            }
+        },
+        "response.OrderPageResponse": {
+            "type": "object",
+            "properties": {
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.OrderResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "total_count": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.OrderResponse": {
+            "type": "object",
+            "properties": {
+                "cancel_note": {
+                    "type": "string"
+                },
+                "cancel_reason": {
+                    "type": "string"
+                },
+                "canceled_amount": {
+                    "type": "integer"
+                },
+                "canceled_at": {
+                    "type": "string"
+                },
+                "canceled_by": {
+                    "type": "string"
+                },
+                "cancellations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.OrderCancellationResponse"
+                    }
+                },
+                "coupon_code": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "discount_amount": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "included_tax": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.OrderItemResponse"
+                    }
+                },
+                "member_number": {
+                    "type": "string"
+                },
+                "order_date": {
+                    "type": "string"
+                },
+                "order_number": {
+                    "type": "string"
+                },
+                "paid_at": {
+                    "type": "string"
+                },
+                "payment": {
+                    "$ref": "#/definitions/response.PaymentResponse"
+                },
+                "payment_amount": {
+                    "type": "integer"
+                },
+                "shipped_at": {
+                    "type": "string"
+                },
+                "shipping_address": {
+                    "$ref": "#/definitions/response.PostalAddressResponse"
+                },
+                "shipping_fee": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tax_amount": {
+                    "type": "integer"
+                },
+                "total_amount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.OrderStatsResponse": {
+            "type": "object",
+            "properties": {
+                "cancel_reasons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.CancelReasonStatResponse"
+                    }
+                },
+                "gross_sales": {
+                    "type": "integer"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "net_sales": {
+                    "type": "integer"
+                },
+                "total_canceled": {
+                    "type": "integer"
+                },
+                "total_refunded": {
+                    "type": "integer"
+                },
+                "total_sales": {
+                    "type": "integer"
+                },
+                "total_tax": {
+                    "type": "integer"
                 }
             }
         },
-        "request.CreateOrderRequest": {
+        "response.PaymentResponse": {
             "type": "object",
             "properties": {
-                "price": {
-                    "type": "integer",
-                    "example": 1000
+                "amount": {
+                    "type": "integer"
                 },
-                "product_number": {
-                    "type": "string",
-                    "example": "Product0fe0dfb2-0a9e-4e47-b670-5d1a761e62b5"
+                "authorized_at": {
+                    "type": "string"
                 },
-                "quantity": {
-                    "type": "integer",
-                    "example": 2
+                "captured_at": {
+                    "type": "string"
+                },
+                "pending_refund": {
+                    "type": "integer"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "refunded_amount": {
+                    "type": "integer"
+                },
+                "refunded_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "string"
                 }
             }
         },
-        "request.CreateProductRequest": {
+        "response.PostalAddressResponse": {
             "type": "object",
             "properties": {
-                "category": {
-                    "type": "string",
-                    "example": "food"
+                "base_address": {
+                    "type": "string"
+                },
+                "detail_address": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "recipient_name": {
+                    "type": "string"
+                },
+                "zip_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.PriceBreakdownItemResponse": {
+            "type": "object",
+            "properties": {
+                "base_amount": {
+                    "type": "integer"
+                },
+                "discount_amount": {
+                    "type": "integer"
                 },
                 "price": {
-                    "type": "integer",
-                    "example": 1000
+                    "type": "integer"
                 },
                 "product_name": {
-                    "type": "string",
-                    "example": "pizza"
+                    "type": "string"
                 },
-                "stock_quantity": {
-                    "type": "integer",
-                    "example": 100
+                "product_number": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "tax_amount": {
+                    "type": "integer"
+                },
+                "tax_inclusive": {
+                    "type": "boolean"
+                },
+                "total_amount": {
+                    "type": "integer"
                 }
             }
         },
-        "request.LoginRequest": {
+        "response.PriceBreakdownResponse": {
             "type": "object",
             "properties": {
-                "account_id": {
-                    "type": "string",
-                    "example": "hong43ok"
+                "base_amount": {
+                    "type": "integer"
                 },
-                "password": {
-                    "type": "string",
-                    "example": "ghdwjddhks"
+                "coupon_code": {
+                    "type": "string"
+                },
+                "discount_amount": {
+                    "type": "integer"
+                },
+                "included_tax": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.PriceBreakdownItemResponse"
+                    }
+                },
+                "shipping_fee": {
+                    "type": "integer"
+                },
+                "tax_amount": {
+                    "type": "integer"
+                },
+                "total_amount": {
+                    "type": "integer"
                 }
             }
         },
-        "request.UpdateMemberRequest": {
+        "response.ProductListResponse": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string",
-                    "example": "hong43ok@naver.com"
+                "has_next": {
+                    "type": "boolean"
                 },
-                "nick_name": {
-                    "type": "string",
-                    "example": "hong"
+                "next_cursor": {
+                    "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "example": "hong"
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProductResponse"
+                    }
+                },
+                "total_count": {
+                    "type": "integer"
                 }
             }
         },
-        "request.UpdateStockRequest": {
+        "response.ProductOptionResponse": {
             "type": "object",
             "properties": {
-                "stock_quantity": {
-                    "type": "integer",
-                    "example": 77
+                "name": {
+                    "type": "string"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "response.LoginResponse": {
+        "response.ProductPriceChangeResponse": {
             "type": "object",
             "properties": {
-                "token": {
+                "changed_at": {
+                    "type": "string"
+                },
+                "changed_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "new_price": {
+                    "type": "integer"
+                },
+                "old_price": {
+                    "type": "integer"
+                },
+                "product_number": {
                     "type": "string"
                 }
             }
         },
-        "response.MemberResponse": {
+        "response.ProductResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "archived_at": {
                     "type": "string"
                 },
-                "email": {
+                "category": {
                     "type": "string"
                 },
-                "full_name": {
+                "category_id": {
+                    "type": "integer"
+                },
+                "description": {
                     "type": "string"
                 },
+                "height": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "is_admin": {
+                "inherits_price": {
                     "type": "boolean"
                 },
-                "is_withdrawn": {
+                "is_available": {
                     "type": "boolean"
                 },
-                "member_number": {
+                "length": {
+                    "type": "integer"
+                },
+                "option_values": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProductOptionResponse"
+                    }
+                },
+                "parent_product_number": {
                     "type": "string"
                 },
-                "username": {
+                "price": {
+                    "type": "integer"
+                },
+                "product_name": {
                     "type": "string"
                 },
-                "withdrawn_at": {
+                "product_number": {
                     "type": "string"
-                }
-            }
-        },
-        "response.MemberStatsResponse": {
-            "type": "object",
-            "properties": {
-                "deleted_members": {
+                },
+                "sku": {
+                    "type": "string"
+                },
+                "stock_quantity": {
                     "type": "integer"
                 },
-                "joined_members": {
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProductResponse"
+                    }
+                },
+                "weight": {
                     "type": "integer"
                 },
-                "month": {
-                    "type": "string"
+                "width": {
+                    "type": "integer"
                 }
             }
         },
-        "response.OrderResponse": {
+        "response.ReturnResponse": {
             "type": "object",
             "properties": {
-                "canceled_at": {
+                "amount": {
+                    "type": "integer"
+                },
+                "approved_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "is_canceled": {
-                    "type": "boolean"
-                },
                 "member_number": {
                     "type": "string"
                 },
-                "order_date": {
-                    "type": "string"
-                },
-                "order_number": {
-                    "type": "string"
-                },
-                "price": {
+                "order_id": {
                     "type": "integer"
                 },
                 "product_number": {
@@ -1062,44 +5297,106 @@ const docTemplate = `{
                 "quantity": {
                     "type": "integer"
                 },
-                "total_amount": {
-                    "type": "integer"
+                "reason": {
+                    "type": "string"
+                },
+                "received_at": {
+                    "type": "string"
+                },
+                "refunded_at": {
+                    "type": "string"
+                },
+                "rejected_at": {
+                    "type": "string"
+                },
+                "requested_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
                 }
             }
         },
-        "response.OrderStatsResponse": {
+        "response.ShipmentResponse": {
             "type": "object",
             "properties": {
-                "month": {
+                "carrier": {
                     "type": "string"
                 },
-                "total_canceled": {
+                "delivered_at": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "total_sales": {
+                "order_id": {
                     "type": "integer"
+                },
+                "shipped_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tracking_number": {
+                    "type": "string"
                 }
             }
         },
-        "response.ProductResponse": {
+        "response.StockMovementResponse": {
             "type": "object",
             "properties": {
-                "category": {
+                "actor": {
+                    "type": "string"
+                },
+                "balance": {
+                    "type": "integer"
+                },
+                "created_at": {
                     "type": "string"
                 },
+                "delta": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "price": {
+                "product_number": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "reference": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.TaxClassResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
                     "type": "integer"
                 },
-                "product_name": {
+                "is_default": {
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string"
                 },
-                "product_number": {
+                "price_mode": {
                     "type": "string"
                 },
-                "stock_quantity": {
+                "rate": {
                     "type": "integer"
                 }
             }
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type PostalAddress struct {
+	RecipientName string `json:"recipient_name"` // 받는 사람
+	Phone         string `json:"phone"`          // 연락처
+	ZipCode       string `json:"zip_code"`       // 우편번호
+	BaseAddress   string `json:"base_address"`   // 기본 주소
+	DetailAddress string `json:"detail_address"` // 상세 주소
+}
+
+type Address struct {
+	ID            int           `gorm:"primaryKey;autoIncrement" json:"id"`       // 기본 키
+	MemberNumber  string        `gorm:"index;not null" json:"member_number"`      // 회원번호
+	Label         string        `json:"label"`                                    // 배송지 이름
+	PostalAddress PostalAddress `gorm:"embedded" json:"postal_address"`           // 주소
+	IsDefault     bool          `gorm:"not null;default:false" json:"is_default"` // 기본 배송지 여부
+	CreatedAt     time.Time     `gorm:"autoCreateTime" json:"created_at"`         // 등록일
+}
+
+func (p *PostalAddress) Validate() error {
+	if p.RecipientName == "" {
+		return errors.New("받는 사람이 누락되었습니다.")
+	}
+	if p.Phone == "" {
+		return errors.New("연락처가 누락되었습니다.")
+	}
+	if p.ZipCode == "" {
+		return errors.New("우편번호가 누락되었습니다.")
+	}
+	if p.BaseAddress == "" {
+		return errors.New("기본 주소가 누락되었습니다.")
+	}
+	return nil
+}
+
+func (p *PostalAddress) IsEmpty() bool {
+	return *p == PostalAddress{}
+}
+
+func (a *Address) Validate() error {
+	if a.MemberNumber == "" {
+		return errors.New("회원번호가 누락되었습니다.")
+	}
+	return a.PostalAddress.Validate()
+}
+
+func (a *Address) IsOwnedBy(memberNumber string) bool {
+	return a.MemberNumber == memberNumber
+}
@@ -0,0 +1,46 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddress_Validate_Success(t *testing.T) {
+	// Given
+	address := &domain.Address{
+		MemberNumber: "M12345",
+		PostalAddress: domain.PostalAddress{
+			RecipientName: "홍길동",
+			Phone:         "010-1234-5678",
+			ZipCode:       "06236",
+			BaseAddress:   "서울특별시 강남구 테헤란로 123",
+		},
+	}
+
+	// When
+	err := address.Validate()
+
+	// Then
+	assert.NoError(t, err)
+}
+
+func TestAddress_Validate_Failure_MissingZipCode(t *testing.T) {
+	// Given
+	address := &domain.Address{
+		MemberNumber: "M12345",
+		PostalAddress: domain.PostalAddress{
+			RecipientName: "홍길동",
+			Phone:         "010-1234-5678",
+			BaseAddress:   "서울특별시 강남구 테헤란로 123",
+		},
+	}
+
+	// When
+	err := address.Validate()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "우편번호가 누락되었습니다.", err.Error())
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type CancellationRejectReason string
+
+const (
+	CancellationRejectShipped       CancellationRejectReason = "shipped"        // 배송 시작 이후
+	CancellationRejectWindowExpired CancellationRejectReason = "window_expired" // 취소 가능 시간 경과
+)
+
+// CancellationPolicy 는 회원이 직접 주문을 취소할 수 있는 조건입니다.
+// 배송이 시작된 주문은 설정과 관계없이 취소할 수 없습니다.
+type CancellationPolicy struct {
+	MaxHoursAfterOrder int            // 주문 후 취소 가능 시간 (0이면 제한 없음)
+	CategoryMaxHours   map[string]int // 카테고리 슬러그별 취소 가능 시간 (기본값보다 우선)
+}
+
+// CancellationRejectedError 는 취소 정책에 의해 취소가 거절되었을 때 반환됩니다.
+type CancellationRejectedError struct {
+	Reason  CancellationRejectReason // 거절 사유 코드
+	Message string                   // 거절 사유
+}
+
+func (e *CancellationRejectedError) Error() string {
+	return e.Message
+}
+
+func (p *CancellationPolicy) Validate() error {
+	if p.MaxHoursAfterOrder < 0 {
+		return errors.New("주문 취소 가능 시간이 잘못되었습니다.")
+	}
+	for _, hours := range p.CategoryMaxHours {
+		if hours <= 0 {
+			return errors.New("카테고리별 주문 취소 가능 시간이 잘못되었습니다.")
+		}
+	}
+	return nil
+}
+
+// Check 는 주문에 포함된 상품 카테고리 중 가장 짧은 취소 가능 시간을 기준으로 취소 가능 여부를 확인합니다.
+// categories 는 상품별 카테고리와 상위 카테고리 슬러그(가까운 순서)이며, 가장 가까운 카테고리의 설정을 적용합니다.
+func (p *CancellationPolicy) Check(order *Order, categories [][]string, now time.Time) error {
+	if order.Status == OrderStatusShipped || order.Status == OrderStatusDelivered {
+		return &CancellationRejectedError{
+			Reason:  CancellationRejectShipped,
+			Message: "배송이 시작된 주문은 취소할 수 없습니다.",
+		}
+	}
+
+	hours, category := p.windowFor(categories)
+	if hours == 0 || now.Before(order.OrderDate.Add(time.Duration(hours)*time.Hour)) {
+		return nil
+	}
+
+	message := fmt.Sprintf("주문 후 %d시간이 지나 취소할 수 없습니다.", hours)
+	if category != "" {
+		message = fmt.Sprintf("%s 카테고리 상품은 주문 후 %d시간까지만 취소할 수 있습니다.", category, hours)
+	}
+	return &CancellationRejectedError{
+		Reason:  CancellationRejectWindowExpired,
+		Message: message,
+	}
+}
+
+// windowFor 는 적용할 취소 가능 시간과, 카테고리 설정이 적용된 경우 해당 카테고리를 반환합니다.
+func (p *CancellationPolicy) windowFor(categories [][]string) (int, string) {
+	hours, matched := p.MaxHoursAfterOrder, ""
+	for _, chain := range categories {
+		for _, category := range chain {
+			override, ok := p.CategoryMaxHours[category]
+			if !ok {
+				continue
+			}
+			if hours == 0 || override < hours {
+				hours, matched = override, category
+			}
+			break
+		}
+	}
+	return hours, matched
+}
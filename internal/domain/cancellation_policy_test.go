@@ -0,0 +1,118 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancellationPolicy_Validate_Failure_InvalidCategoryHours(t *testing.T) {
+	// Given
+	policy := &domain.CancellationPolicy{
+		MaxHoursAfterOrder: 72,
+		CategoryMaxHours:   map[string]int{"food": 0},
+	}
+
+	// When
+	err := policy.Validate()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "카테고리별 주문 취소 가능 시간이 잘못되었습니다.", err.Error())
+}
+
+func TestCancellationPolicy_Check_Success_WithinWindow(t *testing.T) {
+	// Given
+	now := time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC)
+	policy := &domain.CancellationPolicy{MaxHoursAfterOrder: 72}
+	order := &domain.Order{OrderDate: now.Add(-71 * time.Hour), Status: domain.OrderStatusPaid}
+
+	// When
+	err := policy.Check(order, [][]string{{"book"}}, now)
+
+	// Then
+	assert.NoError(t, err)
+}
+
+func TestCancellationPolicy_Check_Failure_WindowExpired(t *testing.T) {
+	// Given
+	now := time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC)
+	policy := &domain.CancellationPolicy{MaxHoursAfterOrder: 72}
+	order := &domain.Order{OrderDate: now.Add(-73 * time.Hour), Status: domain.OrderStatusPaid}
+
+	// When
+	err := policy.Check(order, [][]string{{"book"}}, now)
+
+	// Then
+	var rejected *domain.CancellationRejectedError
+	assert.ErrorAs(t, err, &rejected)
+	assert.Equal(t, domain.CancellationRejectWindowExpired, rejected.Reason)
+	assert.Equal(t, "주문 후 72시간이 지나 취소할 수 없습니다.", err.Error())
+}
+
+func TestCancellationPolicy_Check_Failure_CategoryOverride(t *testing.T) {
+	// Given
+	now := time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC)
+	policy := &domain.CancellationPolicy{
+		MaxHoursAfterOrder: 72,
+		CategoryMaxHours:   map[string]int{"food": 1},
+	}
+	order := &domain.Order{OrderDate: now.Add(-2 * time.Hour), Status: domain.OrderStatusPending}
+
+	// When
+	err := policy.Check(order, [][]string{{"book"}, {"food"}}, now)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "food 카테고리 상품은 주문 후 1시간까지만 취소할 수 있습니다.", err.Error())
+}
+
+func TestCancellationPolicy_Check_Failure_ParentCategoryOverride(t *testing.T) {
+	// Given
+	now := time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC)
+	policy := &domain.CancellationPolicy{
+		MaxHoursAfterOrder: 72,
+		CategoryMaxHours:   map[string]int{"food": 1},
+	}
+	order := &domain.Order{OrderDate: now.Add(-2 * time.Hour), Status: domain.OrderStatusPending}
+
+	// When
+	err := policy.Check(order, [][]string{{"snacks", "food"}}, now)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "food 카테고리 상품은 주문 후 1시간까지만 취소할 수 있습니다.", err.Error())
+}
+
+func TestCancellationPolicy_Check_Success_ClosestCategoryOverride(t *testing.T) {
+	// Given
+	now := time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC)
+	policy := &domain.CancellationPolicy{
+		MaxHoursAfterOrder: 72,
+		CategoryMaxHours:   map[string]int{"food": 1, "snacks": 24},
+	}
+	order := &domain.Order{OrderDate: now.Add(-2 * time.Hour), Status: domain.OrderStatusPending}
+
+	// When
+	err := policy.Check(order, [][]string{{"snacks", "food"}}, now)
+
+	// Then
+	assert.NoError(t, err)
+}
+
+func TestCancellationPolicy_Check_Failure_Shipped(t *testing.T) {
+	// Given
+	now := time.Now()
+	policy := &domain.CancellationPolicy{}
+	order := &domain.Order{OrderDate: now, Status: domain.OrderStatusShipped}
+
+	// When
+	err := policy.Check(order, nil, now)
+
+	// Then
+	var rejected *domain.CancellationRejectedError
+	assert.ErrorAs(t, err, &rejected)
+	assert.Equal(t, domain.CancellationRejectShipped, rejected.Reason)
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type Cart struct {
+	ID           int        `gorm:"primaryKey;autoIncrement" json:"id"`   // 기본 키
+	MemberNumber string     `gorm:"unique;not null" json:"member_number"` // 회원번호
+	Items        []CartItem `gorm:"foreignKey:CartID" json:"items"`       // 장바구니 상품 목록
+	UpdatedAt    time.Time  `gorm:"autoUpdateTime" json:"updated_at"`     // 수정일
+}
+
+type CartItem struct {
+	ID            int    `gorm:"primaryKey;autoIncrement" json:"id"` // 기본 키
+	CartID        int    `gorm:"index;not null" json:"cart_id"`      // 장바구니 기본 키
+	ProductNumber string `gorm:"not null" json:"product_number"`     // 상품번호
+	Quantity      int    `gorm:"not null" json:"quantity"`           // 수량
+}
+
+func (c *Cart) AddItem(productNumber string, quantity int) error {
+	if productNumber == "" {
+		return errors.New("상품번호가 누락되었습니다.")
+	}
+	if quantity <= 0 {
+		return errors.New("수량이 잘못되었습니다.")
+	}
+	for i := range c.Items {
+		if c.Items[i].ProductNumber == productNumber {
+			c.Items[i].Quantity += quantity
+			return nil
+		}
+	}
+	c.Items = append(c.Items, CartItem{
+		CartID:        c.ID,
+		ProductNumber: productNumber,
+		Quantity:      quantity,
+	})
+	return nil
+}
+
+func (c *Cart) UpdateItem(productNumber string, quantity int) error {
+	if quantity <= 0 {
+		return errors.New("수량이 잘못되었습니다.")
+	}
+	for i := range c.Items {
+		if c.Items[i].ProductNumber == productNumber {
+			c.Items[i].Quantity = quantity
+			return nil
+		}
+	}
+	return errors.New("장바구니에 없는 상품입니다.")
+}
+
+func (c *Cart) RemoveItem(productNumber string) error {
+	for i := range c.Items {
+		if c.Items[i].ProductNumber == productNumber {
+			c.Items = append(c.Items[:i], c.Items[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("장바구니에 없는 상품입니다.")
+}
+
+func (c *Cart) Clear() {
+	c.Items = []CartItem{}
+}
+
+func (c *Cart) IsEmpty() bool {
+	return len(c.Items) == 0
+}
@@ -0,0 +1,99 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCart_AddItem_Success(t *testing.T) {
+	// Given
+	cart := &domain.Cart{MemberNumber: "M12345"}
+
+	// When
+	err := cart.AddItem("P12345", 2)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, cart.Items, 1)
+	assert.Equal(t, 2, cart.Items[0].Quantity)
+}
+
+func TestCart_AddItem_Success_MergeSameProduct(t *testing.T) {
+	// Given
+	cart := &domain.Cart{MemberNumber: "M12345"}
+	_ = cart.AddItem("P12345", 2)
+
+	// When
+	err := cart.AddItem("P12345", 3)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, cart.Items, 1)
+	assert.Equal(t, 5, cart.Items[0].Quantity)
+}
+
+func TestCart_AddItem_Failure_InvalidQuantity(t *testing.T) {
+	// Given
+	cart := &domain.Cart{MemberNumber: "M12345"}
+
+	// When
+	err := cart.AddItem("P12345", 0)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "수량이 잘못되었습니다.", err.Error())
+}
+
+func TestCart_UpdateItem_Success(t *testing.T) {
+	// Given
+	cart := &domain.Cart{MemberNumber: "M12345"}
+	_ = cart.AddItem("P12345", 2)
+
+	// When
+	err := cart.UpdateItem("P12345", 7)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 7, cart.Items[0].Quantity)
+}
+
+func TestCart_UpdateItem_Failure_NotInCart(t *testing.T) {
+	// Given
+	cart := &domain.Cart{MemberNumber: "M12345"}
+
+	// When
+	err := cart.UpdateItem("P12345", 7)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "장바구니에 없는 상품입니다.", err.Error())
+}
+
+func TestCart_RemoveItem_Success(t *testing.T) {
+	// Given
+	cart := &domain.Cart{MemberNumber: "M12345"}
+	_ = cart.AddItem("P12345", 2)
+	_ = cart.AddItem("P12346", 1)
+
+	// When
+	err := cart.RemoveItem("P12345")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, cart.Items, 1)
+	assert.Equal(t, "P12346", cart.Items[0].ProductNumber)
+}
+
+func TestCart_Clear_Success(t *testing.T) {
+	// Given
+	cart := &domain.Cart{MemberNumber: "M12345"}
+	_ = cart.AddItem("P12345", 2)
+
+	// When
+	cart.Clear()
+
+	// Then
+	assert.True(t, cart.IsEmpty())
+}
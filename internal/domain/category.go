@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+)
+
+var categorySlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Category 는 상품 분류이며, ParentID로 상하위 구조를 가집니다.
+// 슬러그는 과세 분류, 쿠폰, 취소 정책 설정에서 카테고리를 가리키는 키로 쓰이므로 생성 후 변경하지 않습니다.
+type Category struct {
+	ID        int    `gorm:"primaryKey;autoIncrement" json:"id"`   // 기본 키
+	ParentID  *int   `gorm:"index" json:"parent_id,omitempty"`     // 상위 카테고리 기본 키 (최상위면 nil)
+	Name      string `gorm:"not null" json:"name"`                 // 카테고리명
+	Slug      string `gorm:"unique;not null" json:"slug"`          // 슬러그 (영문 소문자, 숫자, 하이픈)
+	SortOrder int    `gorm:"not null;default:0" json:"sort_order"` // 같은 상위 카테고리 안에서의 정렬 순서
+}
+
+func (c *Category) Validate() error {
+	if c.Name == "" {
+		return errors.New("카테고리명이 누락되었습니다.")
+	}
+	if !categorySlugPattern.MatchString(c.Slug) {
+		return errors.New("슬러그는 영문 소문자, 숫자, 하이픈만 사용할 수 있습니다.")
+	}
+	if c.ParentID != nil && *c.ParentID == c.ID {
+		return errors.New("자기 자신을 상위 카테고리로 지정할 수 없습니다.")
+	}
+	return nil
+}
+
+// MoveTo 는 상위 카테고리를 변경합니다. parent가 nil이면 최상위로 이동하며, 자신의 하위 카테고리 아래로는 이동할 수 없습니다.
+func (c *Category) MoveTo(parent *Category, categories []*Category) error {
+	if parent == nil {
+		c.ParentID = nil
+		return nil
+	}
+	for _, id := range CategoryDescendantIDs(categories, c.ID) {
+		if id == parent.ID {
+			return errors.New("하위 카테고리 아래로 이동할 수 없습니다.")
+		}
+	}
+	c.ParentID = &parent.ID
+	return nil
+}
+
+// CategoryDescendantIDs 는 rootID와 그 아래 모든 하위 카테고리의 기본 키를 반환합니다.
+func CategoryDescendantIDs(categories []*Category, rootID int) []int {
+	childrenOf := make(map[int][]int)
+	for _, category := range categories {
+		if category.ParentID != nil {
+			childrenOf[*category.ParentID] = append(childrenOf[*category.ParentID], category.ID)
+		}
+	}
+
+	ids := []int{rootID}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, childrenOf[ids[i]]...)
+	}
+	return ids
+}
+
+// CategoryAncestorSlugs 는 slug와 그 위의 모든 상위 카테고리 슬러그를 가까운 순서로 반환합니다.
+// 등록되지 않은 슬러그면 해당 값만 반환합니다.
+func CategoryAncestorSlugs(categories []*Category, slug string) []string {
+	byID := make(map[int]*Category, len(categories))
+	var current *Category
+	for _, category := range categories {
+		byID[category.ID] = category
+		if category.Slug == slug {
+			current = category
+		}
+	}
+
+	slugs := []string{slug}
+	visited := make(map[int]bool)
+	for current != nil && current.ParentID != nil && !visited[current.ID] {
+		visited[current.ID] = true
+		current = byID[*current.ParentID]
+		if current != nil {
+			slugs = append(slugs, current.Slug)
+		}
+	}
+	return slugs
+}
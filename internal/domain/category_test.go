@@ -0,0 +1,91 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestCategory_Validate_Success(t *testing.T) {
+	// Given
+	category := &domain.Category{Name: "과자", Slug: "snacks-and-chips"}
+
+	// When
+	err := category.Validate()
+
+	// Then
+	assert.NoError(t, err)
+}
+
+func TestCategory_Validate_Failure_InvalidSlug(t *testing.T) {
+	// Given
+	category := &domain.Category{Name: "식품", Slug: "Food"}
+
+	// When
+	err := category.Validate()
+
+	// Then
+	assert.EqualError(t, err, "슬러그는 영문 소문자, 숫자, 하이픈만 사용할 수 있습니다.")
+}
+
+func TestCategoryDescendantIDs(t *testing.T) {
+	// Given
+	categories := []*domain.Category{
+		{ID: 1, Name: "식품", Slug: "food"},
+		{ID: 2, ParentID: intPtr(1), Name: "과자", Slug: "snacks"},
+		{ID: 3, ParentID: intPtr(2), Name: "초콜릿", Slug: "chocolate"},
+		{ID: 4, Name: "의류", Slug: "clothing"},
+	}
+
+	// When
+	ids := domain.CategoryDescendantIDs(categories, 1)
+
+	// Then
+	assert.ElementsMatch(t, []int{1, 2, 3}, ids)
+}
+
+func TestCategoryAncestorSlugs(t *testing.T) {
+	// Given
+	categories := []*domain.Category{
+		{ID: 1, Name: "식품", Slug: "food"},
+		{ID: 2, ParentID: intPtr(1), Name: "과자", Slug: "snacks"},
+		{ID: 3, ParentID: intPtr(2), Name: "초콜릿", Slug: "chocolate"},
+		{ID: 4, Name: "의류", Slug: "clothing"},
+	}
+
+	// When & Then
+	assert.Equal(t, []string{"chocolate", "snacks", "food"}, domain.CategoryAncestorSlugs(categories, "chocolate"))
+	assert.Equal(t, []string{"clothing"}, domain.CategoryAncestorSlugs(categories, "clothing"))
+	assert.Equal(t, []string{"unknown"}, domain.CategoryAncestorSlugs(categories, "unknown"))
+}
+
+func TestCategory_MoveTo_Failure_Descendant(t *testing.T) {
+	// Given
+	food := &domain.Category{ID: 1, Name: "식품", Slug: "food"}
+	snacks := &domain.Category{ID: 2, ParentID: intPtr(1), Name: "과자", Slug: "snacks"}
+	categories := []*domain.Category{food, snacks}
+
+	// When
+	err := food.MoveTo(snacks, categories)
+
+	// Then
+	assert.EqualError(t, err, "하위 카테고리 아래로 이동할 수 없습니다.")
+	assert.Nil(t, food.ParentID)
+}
+
+func TestCategory_MoveTo_Success_Root(t *testing.T) {
+	// Given
+	snacks := &domain.Category{ID: 2, ParentID: intPtr(1), Name: "과자", Slug: "snacks"}
+
+	// When
+	err := snacks.MoveTo(nil, nil)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Nil(t, snacks.ParentID)
+}
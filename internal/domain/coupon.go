@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrCouponExhausted = errors.New("쿠폰 사용 가능 횟수를 초과했습니다.")
+
+type CouponDiscountType string
+
+const (
+	CouponDiscountTypeFixed      CouponDiscountType = "fixed"      // 정액 할인
+	CouponDiscountTypePercentage CouponDiscountType = "percentage" // 정률 할인
+)
+
+type Coupon struct {
+	ID                int                `gorm:"primaryKey;autoIncrement" json:"id"`               // 기본 키
+	Code              string             `gorm:"unique;not null" json:"code"`                      // 쿠폰 코드
+	Name              string             `gorm:"not null" json:"name"`                             // 쿠폰명
+	DiscountType      CouponDiscountType `gorm:"type:varchar(20);not null" json:"discount_type"`   // 할인 방식
+	DiscountValue     int64              `gorm:"not null" json:"discount_value"`                   // 할인 금액 또는 할인율
+	MaxDiscountAmount int64              `gorm:"not null;default:0" json:"max_discount_amount"`    // 최대 할인 금액 (0이면 제한 없음)
+	MinOrderAmount    int64              `gorm:"not null;default:0" json:"min_order_amount"`       // 최소 주문 금액
+	StartsAt          time.Time          `gorm:"not null" json:"starts_at"`                        // 사용 시작일
+	EndsAt            time.Time          `gorm:"not null" json:"ends_at"`                          // 사용 종료일
+	UsageLimit        int                `gorm:"not null;default:0" json:"usage_limit"`            // 전체 사용 한도 (0이면 제한 없음)
+	PerMemberLimit    int                `gorm:"not null;default:0" json:"per_member_limit"`       // 회원별 사용 한도 (0이면 제한 없음)
+	UsedCount         int                `gorm:"not null;default:0" json:"used_count"`             // 사용 횟수
+	Categories        []string           `gorm:"type:text;serializer:json" json:"categories"`      // 적용 카테고리 슬러그 (비어 있으면 전체)
+	ProductNumbers    []string           `gorm:"type:text;serializer:json" json:"product_numbers"` // 적용 상품번호 (비어 있으면 전체)
+}
+
+type CouponUsage struct {
+	ID             int       `gorm:"primaryKey;autoIncrement" json:"id"`   // 기본 키
+	CouponID       int       `gorm:"index;not null" json:"coupon_id"`      // 쿠폰 기본 키
+	MemberNumber   string    `gorm:"index;not null" json:"member_number"`  // 회원번호
+	OrderID        int       `gorm:"uniqueIndex;not null" json:"order_id"` // 주문 기본 키
+	DiscountAmount int64     `gorm:"not null" json:"discount_amount"`      // 할인 금액
+	UsedAt         time.Time `gorm:"not null" json:"used_at"`              // 사용일
+}
+
+func (c *Coupon) Validate() error {
+	if c.Code == "" {
+		return errors.New("쿠폰 코드가 누락되었습니다.")
+	}
+	if c.Name == "" {
+		return errors.New("쿠폰명이 누락되었습니다.")
+	}
+	switch c.DiscountType {
+	case CouponDiscountTypeFixed:
+	case CouponDiscountTypePercentage:
+		if c.DiscountValue > 100 {
+			return errors.New("할인율은 100을 넘을 수 없습니다.")
+		}
+	default:
+		return errors.New("유효하지 않은 할인 방식입니다.")
+	}
+	if c.DiscountValue <= 0 {
+		return errors.New("할인 값이 잘못되었습니다.")
+	}
+	if c.MaxDiscountAmount < 0 || c.MinOrderAmount < 0 {
+		return errors.New("쿠폰 금액 조건이 잘못되었습니다.")
+	}
+	if c.StartsAt.IsZero() || c.EndsAt.IsZero() || !c.EndsAt.After(c.StartsAt) {
+		return errors.New("쿠폰 사용 기간이 잘못되었습니다.")
+	}
+	if c.UsageLimit < 0 || c.PerMemberLimit < 0 {
+		return errors.New("쿠폰 사용 한도가 잘못되었습니다.")
+	}
+	return nil
+}
+
+func (c *Coupon) CheckAvailable(now time.Time) error {
+	if now.Before(c.StartsAt) || !now.Before(c.EndsAt) {
+		return errors.New("사용 기간이 아닌 쿠폰입니다.")
+	}
+	if c.UsageLimit > 0 && c.UsedCount >= c.UsageLimit {
+		return ErrCouponExhausted
+	}
+	return nil
+}
+
+// AppliesTo 는 상품번호나 카테고리가 쿠폰 적용 대상인지 확인합니다.
+// ancestors 는 상품 카테고리의 상위 카테고리 슬러그이며, 상위 카테고리가 지정된 쿠폰은 하위 카테고리 상품에도 적용됩니다.
+func (c *Coupon) AppliesTo(product *Product, ancestors ...string) bool {
+	if len(c.Categories) == 0 && len(c.ProductNumbers) == 0 {
+		return true
+	}
+	for _, productNumber := range c.ProductNumbers {
+		if productNumber == product.ProductNumber {
+			return true
+		}
+	}
+	for _, category := range c.Categories {
+		if category == product.Category {
+			return true
+		}
+		for _, ancestor := range ancestors {
+			if category == ancestor {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// 최소 주문 금액은 전체 주문 금액, 할인은 적용 대상 상품 금액 기준으로 계산
+func (c *Coupon) CalculateDiscount(orderAmount int64, eligibleAmount int64) (int64, error) {
+	if orderAmount < c.MinOrderAmount {
+		return 0, errors.New("최소 주문 금액을 충족하지 않습니다.")
+	}
+	if eligibleAmount <= 0 {
+		return 0, errors.New("쿠폰을 적용할 수 있는 상품이 없습니다.")
+	}
+
+	var discount int64
+	switch c.DiscountType {
+	case CouponDiscountTypeFixed:
+		discount = c.DiscountValue
+	case CouponDiscountTypePercentage:
+		discount = eligibleAmount * c.DiscountValue / 100
+	}
+	if c.MaxDiscountAmount > 0 && discount > c.MaxDiscountAmount {
+		discount = c.MaxDiscountAmount
+	}
+	if discount > eligibleAmount {
+		discount = eligibleAmount
+	}
+	return discount, nil
+}
@@ -0,0 +1,135 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCoupon() *domain.Coupon {
+	return &domain.Coupon{
+		Code:          "WELCOME10",
+		Name:          "신규 회원 10% 할인",
+		DiscountType:  domain.CouponDiscountTypePercentage,
+		DiscountValue: 10,
+		StartsAt:      time.Now().Add(-time.Hour),
+		EndsAt:        time.Now().Add(time.Hour),
+	}
+}
+
+func TestCoupon_Validate_Success(t *testing.T) {
+	// Given
+	coupon := newTestCoupon()
+
+	// When
+	err := coupon.Validate()
+
+	// Then
+	assert.NoError(t, err)
+}
+
+func TestCoupon_Validate_Failure_InvalidPercentage(t *testing.T) {
+	// Given
+	coupon := newTestCoupon()
+	coupon.DiscountValue = 120
+
+	// When
+	err := coupon.Validate()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "할인율은 100을 넘을 수 없습니다.", err.Error())
+}
+
+func TestCoupon_Validate_Failure_InvalidPeriod(t *testing.T) {
+	// Given
+	coupon := newTestCoupon()
+	coupon.EndsAt = coupon.StartsAt.Add(-time.Hour)
+
+	// When
+	err := coupon.Validate()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "쿠폰 사용 기간이 잘못되었습니다.", err.Error())
+}
+
+func TestCoupon_CheckAvailable_Failure_Expired(t *testing.T) {
+	// Given
+	coupon := newTestCoupon()
+
+	// When
+	err := coupon.CheckAvailable(time.Now().Add(2 * time.Hour))
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "사용 기간이 아닌 쿠폰입니다.", err.Error())
+}
+
+func TestCoupon_CheckAvailable_Failure_Exhausted(t *testing.T) {
+	// Given
+	coupon := newTestCoupon()
+	coupon.UsageLimit = 1
+	coupon.UsedCount = 1
+
+	// When
+	err := coupon.CheckAvailable(time.Now())
+
+	// Then
+	assert.ErrorIs(t, err, domain.ErrCouponExhausted)
+}
+
+func TestCoupon_AppliesTo(t *testing.T) {
+	// Given
+	coupon := newTestCoupon()
+	coupon.Categories = []string{"food"}
+	coupon.ProductNumbers = []string{"P99999"}
+
+	// When & Then
+	assert.True(t, coupon.AppliesTo(&domain.Product{ProductNumber: "P12345", Category: "food"}))
+	assert.True(t, coupon.AppliesTo(&domain.Product{ProductNumber: "P99999", Category: "book"}))
+	assert.False(t, coupon.AppliesTo(&domain.Product{ProductNumber: "P12346", Category: "book"}))
+	assert.True(t, coupon.AppliesTo(&domain.Product{ProductNumber: "P12347", Category: "snacks"}, "food"))
+}
+
+func TestCoupon_CalculateDiscount_Success_PercentageWithCap(t *testing.T) {
+	// Given
+	coupon := newTestCoupon()
+	coupon.MaxDiscountAmount = 500
+
+	// When
+	discount, err := coupon.CalculateDiscount(10000, 8000)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 500, discount)
+}
+
+func TestCoupon_CalculateDiscount_Success_FixedLimitedToEligibleAmount(t *testing.T) {
+	// Given
+	coupon := newTestCoupon()
+	coupon.DiscountType = domain.CouponDiscountTypeFixed
+	coupon.DiscountValue = 3000
+
+	// When
+	discount, err := coupon.CalculateDiscount(5000, 2000)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2000, discount)
+}
+
+func TestCoupon_CalculateDiscount_Failure_MinOrderAmount(t *testing.T) {
+	// Given
+	coupon := newTestCoupon()
+	coupon.MinOrderAmount = 10000
+
+	// When
+	_, err := coupon.CalculateDiscount(9000, 9000)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "최소 주문 금액을 충족하지 않습니다.", err.Error())
+}
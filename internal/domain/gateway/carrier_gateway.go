@@ -0,0 +1,14 @@
+package gateway
+
+import "time"
+
+type TrackingInfo struct {
+	Delivered   bool       // 배송 완료 여부
+	DeliveredAt *time.Time // 배송 완료일
+}
+
+type CarrierGateway interface {
+	Carrier() string
+	Register(orderNumber string) (string, error)
+	Track(trackingNumber string) (*TrackingInfo, error)
+}
@@ -0,0 +1,9 @@
+package gateway
+
+type PaymentGateway interface {
+	Provider() string
+	Authorize(orderNumber string, amount int64) (string, error)
+	Capture(transactionID string, amount int64) error
+	Void(transactionID string, amount int64) error   // 매입 전 승인 금액 취소
+	Refund(transactionID string, amount int64) error // 매입 후 환불
+}
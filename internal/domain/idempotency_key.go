@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// IdempotencyKeyTTL 는 멱등 키의 유효 기간입니다. 지나면 같은 키로 새 요청을 처리하며, 처리 중 상태로 남은 키도 이때 정리됩니다.
+const IdempotencyKeyTTL = 24 * time.Hour
+
+type IdempotencyKey struct {
+	ID             int       `gorm:"primaryKey;autoIncrement" json:"id"`                                                // 기본 키
+	MemberNumber   string    `gorm:"not null;uniqueIndex:idx_member_idempotency_key" json:"member_number"`              // 회원번호
+	Key            string    `gorm:"column:idempotency_key;not null;uniqueIndex:idx_member_idempotency_key" json:"key"` // 멱등 키
+	RequestHash    string    `gorm:"not null" json:"request_hash"`                                                      // 요청 해시
+	ResponseStatus int       `gorm:"not null;default:0" json:"response_status"`                                         // 응답 상태 코드 (0이면 처리 중)
+	ResponseBody   string    `gorm:"type:text" json:"response_body"`                                                    // 응답 본문
+	CreatedAt      time.Time `gorm:"autoCreateTime" json:"created_at"`                                                  // 생성일
+}
+
+func (k *IdempotencyKey) Matches(requestHash string) bool {
+	return k.RequestHash == requestHash
+}
+
+func (k *IdempotencyKey) IsCompleted() bool {
+	return k.ResponseStatus != 0
+}
+
+func (k *IdempotencyKey) IsExpired(now time.Time) bool {
+	return !now.Before(k.CreatedAt.Add(IdempotencyKeyTTL))
+}
+
+func (k *IdempotencyKey) Complete(status int, body string) {
+	k.ResponseStatus = status
+	k.ResponseBody = body
+}
@@ -0,0 +1,42 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencyKey_Matches(t *testing.T) {
+	// Given
+	key := &domain.IdempotencyKey{MemberNumber: "M12345", Key: "key-1", RequestHash: "hash-1"}
+
+	// When & Then
+	assert.True(t, key.Matches("hash-1"))
+	assert.False(t, key.Matches("hash-2"))
+}
+
+func TestIdempotencyKey_Complete(t *testing.T) {
+	// Given
+	key := &domain.IdempotencyKey{MemberNumber: "M12345", Key: "key-1", RequestHash: "hash-1"}
+	assert.False(t, key.IsCompleted())
+
+	// When
+	key.Complete(201, `{"message":"주문이 등록되었습니다."}`)
+
+	// Then
+	assert.True(t, key.IsCompleted())
+	assert.Equal(t, 201, key.ResponseStatus)
+	assert.Equal(t, `{"message":"주문이 등록되었습니다."}`, key.ResponseBody)
+}
+
+func TestIdempotencyKey_IsExpired(t *testing.T) {
+	// Given
+	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	key := &domain.IdempotencyKey{MemberNumber: "M12345", Key: "key-1", RequestHash: "hash-1", CreatedAt: createdAt}
+
+	// When & Then
+	assert.False(t, key.IsExpired(createdAt.Add(domain.IdempotencyKeyTTL-time.Second)))
+	assert.True(t, key.IsExpired(createdAt.Add(domain.IdempotencyKeyTTL)))
+}
@@ -2,20 +2,97 @@ package domain
 
 import (
 	"errors"
+	"strings"
 	"time"
 )
 
+type OrderStatus string
+
+const (
+	OrderStatusPending   OrderStatus = "pending"   // 주문 접수
+	OrderStatusPaid      OrderStatus = "paid"      // 결제 완료
+	OrderStatusShipped   OrderStatus = "shipped"   // 배송 중
+	OrderStatusDelivered OrderStatus = "delivered" // 배송 완료
+	OrderStatusCanceled  OrderStatus = "canceled"  // 주문 취소
+)
+
 type Order struct {
-	ID            int        `gorm:"primaryKey;autoIncrement" json:"id"`  // 기본 키
-	OrderNumber   string     `gorm:"unique;not null" json:"order_number"` // 주문번호
-	OrderDate     time.Time  `gorm:"not null" json:"order_date"`          // 주문일
-	MemberNumber  string     `gorm:"not null" json:"member_number"`       // 회원번호
-	ProductNumber string     `gorm:"not null" json:"product_number"`      // 상품번호
-	Price         int64      `gorm:"not null" json:"price"`               // 가격
-	Quantity      int        `gorm:"not null" json:"quantity"`            // 수량
-	TotalAmount   int64      `gorm:"not null" json:"total_amount"`        // 금액
-	IsCanceled    bool       `gorm:"default:false" json:"is_canceled"`    // 취소여부
-	CanceledAt    *time.Time `json:"canceled_at,omitempty"`               // 취소일
+	ID              int                 `gorm:"primaryKey;autoIncrement" json:"id"`                            // 기본 키
+	OrderNumber     string              `gorm:"unique;not null" json:"order_number"`                           // 주문번호
+	OrderDate       time.Time           `gorm:"not null" json:"order_date"`                                    // 주문일
+	MemberNumber    string              `gorm:"not null" json:"member_number"`                                 // 회원번호
+	Items           []OrderItem         `gorm:"foreignKey:OrderID" json:"items"`                               // 주문 상품 목록
+	ShippingAddress PostalAddress       `gorm:"embedded;embeddedPrefix:shipping_" json:"shipping_address"`     // 주문 당시 배송지
+	TotalAmount     int64               `gorm:"not null" json:"total_amount"`                                  // 금액
+	CouponCode      string              `json:"coupon_code,omitempty"`                                         // 쿠폰 코드
+	DiscountAmount  int64               `gorm:"not null;default:0" json:"discount_amount"`                     // 할인 금액
+	TaxAmount       int64               `gorm:"not null;default:0" json:"tax_amount"`                          // 세금
+	IncludedTax     int64               `gorm:"not null;default:0" json:"included_tax"`                        // 가격에 포함된 세금
+	ShippingFee     int64               `gorm:"not null;default:0" json:"shipping_fee"`                        // 배송비
+	CanceledAmount  int64               `gorm:"not null;default:0" json:"canceled_amount"`                     // 취소 금액
+	Status          OrderStatus         `gorm:"type:varchar(20);not null;default:pending;index" json:"status"` // 주문상태
+	PaidAt          *time.Time          `json:"paid_at,omitempty"`                                             // 결제일
+	ShippedAt       *time.Time          `json:"shipped_at,omitempty"`                                          // 배송 시작일
+	DeliveredAt     *time.Time          `json:"delivered_at,omitempty"`                                        // 배송 완료일
+	CanceledAt      *time.Time          `json:"canceled_at,omitempty"`                                         // 취소일
+	CanceledBy      string              `json:"canceled_by,omitempty"`                                         // 취소 처리자 회원번호
+	CancelReason    CancelReason        `gorm:"type:varchar(30)" json:"cancel_reason,omitempty"`               // 취소 사유 코드
+	CancelNote      string              `json:"cancel_note,omitempty"`                                         // 취소 사유 상세
+	Payment         *Payment            `gorm:"foreignKey:OrderID" json:"payment,omitempty"`                   // 결제 정보
+	Cancellations   []OrderCancellation `gorm:"foreignKey:OrderID" json:"cancellations,omitempty"`             // 취소 내역
+}
+
+type OrderItem struct {
+	ID               int               `gorm:"primaryKey;autoIncrement" json:"id"`                       // 기본 키
+	OrderID          int               `gorm:"index;not null" json:"order_id"`                           // 주문 기본 키
+	ProductNumber    string            `gorm:"not null" json:"product_number"`                           // 상품번호
+	ProductName      string            `json:"product_name"`                                             // 주문 당시 상품명
+	SKU              string            `gorm:"not null;default:''" json:"sku,omitempty"`                 // 주문 당시 재고 관리 코드 (옵션 상품인 경우)
+	OptionValues     map[string]string `gorm:"type:text;serializer:json" json:"option_values,omitempty"` // 주문 당시 옵션 값 (옵션 상품인 경우)
+	Price            int64             `gorm:"not null" json:"price"`                                    // 주문 당시 가격
+	Quantity         int               `gorm:"not null" json:"quantity"`                                 // 수량
+	CanceledQuantity int               `gorm:"not null;default:0" json:"canceled_quantity"`              // 취소 수량
+	ReturnedQuantity int               `gorm:"not null;default:0" json:"returned_quantity"`              // 반품 수량
+	LineTotal        int64             `gorm:"not null" json:"line_total"`                               // 상품별 금액
+	DiscountAmount   int64             `gorm:"not null;default:0" json:"discount_amount"`                // 상품별 할인 금액
+	TaxAmount        int64             `gorm:"not null;default:0" json:"tax_amount"`                     // 상품별 세금
+	TaxInclusive     bool              `gorm:"not null;default:false" json:"tax_inclusive"`              // 세금 포함 가격 여부
+}
+
+type CancelReason string
+
+const (
+	CancelReasonCustomerRequest CancelReason = "customer_request" // 고객 요청
+	CancelReasonFraud           CancelReason = "fraud"            // 부정 거래
+	CancelReasonOutOfStock      CancelReason = "out_of_stock"     // 재고 부족
+	CancelReasonUndeliverable   CancelReason = "undeliverable"    // 배송 불가
+	CancelReasonPaymentIssue    CancelReason = "payment_issue"    // 결제 문제
+	CancelReasonOther           CancelReason = "other"            // 기타
+)
+
+type CancelReasonStat struct {
+	Reason CancelReason // 취소 사유 코드
+	Count  int64        // 취소 주문 수
+	Amount int64        // 취소 금액
+}
+
+type OrderStats struct {
+	TotalSales    int64              // 할인을 반영한 매출액 (배송비 제외)
+	NetSales      int64              // 할인, 세금, 배송비를 제외한 순매출액
+	TotalTax      int64              // 세금
+	GrossSales    int64              // 세금과 배송비를 포함한 총매출액
+	TotalCanceled int64              // 취소액
+	CancelReasons []CancelReasonStat // 취소 사유별 전체 취소 내역
+	TotalRefunded int64              // 반품 환불액
+}
+
+type OrderCancellation struct {
+	ID            int       `gorm:"primaryKey;autoIncrement" json:"id"` // 기본 키
+	OrderID       int       `gorm:"index;not null" json:"order_id"`     // 주문 기본 키
+	ProductNumber string    `gorm:"not null" json:"product_number"`     // 상품번호
+	Quantity      int       `gorm:"not null" json:"quantity"`           // 취소 수량
+	Amount        int64     `gorm:"not null" json:"amount"`             // 취소 금액
+	CanceledAt    time.Time `gorm:"not null" json:"canceled_at"`        // 취소일
 }
 
 func (o *Order) Validate() error {
@@ -28,27 +105,293 @@ func (o *Order) Validate() error {
 	if o.MemberNumber == "" {
 		return errors.New("회원번호가 누락되었습니다.")
 	}
-	if o.ProductNumber == "" {
-		return errors.New("상품번호가 누락되었습니다.")
+	if len(o.Items) == 0 {
+		return errors.New("주문 상품이 누락되었습니다.")
 	}
-	if o.Price <= 0 {
-		return errors.New("가격이 잘못되었습니다.")
+	for i := range o.Items {
+		if err := o.Items[i].Validate(); err != nil {
+			return err
+		}
 	}
-	if o.Quantity <= 0 {
-		return errors.New("수량이 잘못되었습니다.")
+	return nil
+}
+
+func ParseOrderStatus(status string) (OrderStatus, error) {
+	switch OrderStatus(status) {
+	case OrderStatusPending, OrderStatusPaid, OrderStatusShipped, OrderStatusDelivered, OrderStatusCanceled:
+		return OrderStatus(status), nil
+	}
+	return "", errors.New("유효하지 않은 주문 상태입니다.")
+}
+
+func ParseCancelReason(reason string) (CancelReason, error) {
+	switch CancelReason(reason) {
+	case CancelReasonCustomerRequest, CancelReasonFraud, CancelReasonOutOfStock, CancelReasonUndeliverable, CancelReasonPaymentIssue, CancelReasonOther:
+		return CancelReason(reason), nil
 	}
-	if o.TotalAmount <= 0 {
-		return errors.New("금액이 잘못되었습니다.")
+	return "", errors.New("유효하지 않은 취소 사유입니다.")
+}
+
+func (o *Order) IsCanceled() bool {
+	return o.Status == OrderStatusCanceled
+}
+
+func (o *Order) MarkPaid() error {
+	if o.Status != OrderStatusPending {
+		return errors.New("결제 대기 중인 주문만 결제 완료 처리할 수 있습니다.")
 	}
+	now := time.Now()
+	o.Status = OrderStatusPaid
+	o.PaidAt = &now
+	return nil
+}
+
+func (o *Order) Ship() error {
+	if o.Status != OrderStatusPaid {
+		return errors.New("결제 완료된 주문만 배송할 수 있습니다.")
+	}
+	now := time.Now()
+	o.Status = OrderStatusShipped
+	o.ShippedAt = &now
+	return nil
+}
+
+func (o *Order) Deliver() error {
+	if o.Status != OrderStatusShipped {
+		return errors.New("배송 중인 주문만 배송 완료 처리할 수 있습니다.")
+	}
+	now := time.Now()
+	o.Status = OrderStatusDelivered
+	o.DeliveredAt = &now
 	return nil
 }
 
 func (o *Order) Cancel() error {
-	if o.IsCanceled {
-		return errors.New("이미 취소된 주문입니다.")
+	return o.cancel(o.MemberNumber, CancelReasonCustomerRequest, "")
+}
+
+// CancelByAdmin 은 관리자가 사유 코드와 상세 사유를 남기고 주문을 취소합니다.
+func (o *Order) CancelByAdmin(adminNumber string, reason CancelReason, note string) error {
+	if _, err := ParseCancelReason(string(reason)); err != nil {
+		return err
+	}
+	if strings.TrimSpace(note) == "" {
+		return errors.New("취소 사유 상세가 누락되었습니다.")
+	}
+	return o.cancel(adminNumber, reason, note)
+}
+
+func (o *Order) cancel(canceledBy string, reason CancelReason, note string) error {
+	if err := o.checkCancelable(); err != nil {
+		return err
+	}
+	// 남아 있는 수량을 모두 취소 내역으로 기록
+	now := time.Now()
+	for i := range o.Items {
+		if quantity := o.Items[i].ActiveQuantity(); quantity > 0 {
+			o.cancelItem(&o.Items[i], quantity, now)
+		}
+	}
+	o.markCanceled(now, canceledBy, reason, note)
+	return nil
+}
+
+func (o *Order) PartialCancel(productNumber string, quantity int) (*OrderCancellation, error) {
+	if err := o.checkCancelable(); err != nil {
+		return nil, err
 	}
-	o.IsCanceled = true
+	item := o.findItem(productNumber)
+	if item == nil {
+		return nil, errors.New("주문에 없는 상품입니다.")
+	}
+	if quantity <= 0 || quantity > item.ActiveQuantity() {
+		return nil, errors.New("취소 수량이 잘못되었습니다.")
+	}
+
 	now := time.Now()
+	cancellation := o.cancelItem(item, quantity, now)
+
+	// 모든 상품이 취소되면 주문 전체를 취소 상태로 변경
+	if !o.hasActiveItems() {
+		o.markCanceled(now, o.MemberNumber, CancelReasonCustomerRequest, "")
+	}
+	return cancellation, nil
+}
+
+func (o *Order) RequestReturn(productNumber string, quantity int, reason string) (*ReturnRequest, error) {
+	if o.Status != OrderStatusDelivered {
+		return nil, errors.New("배송 완료된 주문만 반품할 수 있습니다.")
+	}
+	item := o.findItem(productNumber)
+	if item == nil {
+		return nil, errors.New("주문에 없는 상품입니다.")
+	}
+	if quantity <= 0 || quantity > item.ReturnableQuantity() {
+		return nil, errors.New("반품 수량이 잘못되었습니다.")
+	}
+
+	returnRequest := &ReturnRequest{
+		OrderID:       o.ID,
+		MemberNumber:  o.MemberNumber,
+		ProductNumber: productNumber,
+		Quantity:      quantity,
+		Amount:        o.RefundAmountFor(productNumber, quantity),
+		Reason:        reason,
+		Status:        ReturnStatusRequested,
+		RequestedAt:   time.Now(),
+	}
+	if err := returnRequest.Validate(); err != nil {
+		return nil, err
+	}
+	item.ReturnedQuantity += quantity
+	return returnRequest, nil
+}
+
+// 거절된 반품 수량을 다시 반품 가능 수량으로 돌려놓음
+func (o *Order) ReleaseReturn(returnRequest *ReturnRequest) {
+	if item := o.findItem(returnRequest.ProductNumber); item != nil {
+		item.ReturnedQuantity -= returnRequest.Quantity
+	}
+}
+
+// 배송지 정보를 복사해 두어 이후 주소록이 변경되어도 주문의 배송지는 유지
+func (o *Order) AssignShippingAddress(address *Address) {
+	o.ShippingAddress = address.PostalAddress
+}
+
+func (o *Order) ApplyPriceBreakdown(breakdown *PriceBreakdown) error {
+	lines := make(map[string]PriceBreakdownItem, len(breakdown.Items))
+	for _, line := range breakdown.Items {
+		lines[line.ProductNumber] = line
+	}
+	for i := range o.Items {
+		line, ok := lines[o.Items[i].ProductNumber]
+		if !ok || line.Quantity != o.Items[i].Quantity {
+			return errors.New("주문 상품과 가격 정보가 일치하지 않습니다.")
+		}
+		o.Items[i].ProductName = line.ProductName
+		o.Items[i].SKU = line.SKU
+		o.Items[i].OptionValues = line.OptionValues
+		o.Items[i].Price = line.Price
+		o.Items[i].LineTotal = line.BaseAmount
+		o.Items[i].DiscountAmount = line.DiscountAmount
+		o.Items[i].TaxAmount = line.TaxAmount
+		o.Items[i].TaxInclusive = line.TaxInclusive
+	}
+	o.CouponCode = breakdown.CouponCode
+	o.TotalAmount = breakdown.BaseAmount
+	o.DiscountAmount = breakdown.DiscountAmount
+	o.TaxAmount = breakdown.TaxAmount
+	o.IncludedTax = breakdown.IncludedTax
+	o.ShippingFee = breakdown.ShippingFee
+	return nil
+}
+
+func (o *Order) PaymentAmount() int64 {
+	return o.TotalAmount - o.DiscountAmount + o.TaxAmount - o.IncludedTax + o.ShippingFee
+}
+
+// 상품별 할인과 세금을 수량 비율로 나누어 실제 환불할 금액을 계산
+func (o *Order) RefundAmountFor(productNumber string, quantity int) int64 {
+	item := o.findItem(productNumber)
+	if item == nil || item.Quantity == 0 {
+		return 0
+	}
+	return (item.LineTotal - item.DiscountAmount + item.AddedTax()) * int64(quantity) / int64(item.Quantity)
+}
+
+func (o *Order) ActiveAmount() int64 {
+	return o.TotalAmount - o.CanceledAmount
+}
+
+func (o *Order) markCanceled(now time.Time, canceledBy string, reason CancelReason, note string) {
+	o.Status = OrderStatusCanceled
 	o.CanceledAt = &now
+	o.CanceledBy = canceledBy
+	o.CancelReason = reason
+	o.CancelNote = note
+}
+
+func (o *Order) checkCancelable() error {
+	switch o.Status {
+	case OrderStatusCanceled:
+		return errors.New("이미 취소된 주문입니다.")
+	case OrderStatusShipped, OrderStatusDelivered:
+		return errors.New("배송이 시작된 주문은 취소할 수 없습니다.")
+	}
+	return nil
+}
+
+func (o *Order) findItem(productNumber string) *OrderItem {
+	for i := range o.Items {
+		if o.Items[i].ProductNumber == productNumber {
+			return &o.Items[i]
+		}
+	}
+	return nil
+}
+
+func (o *Order) hasActiveItems() bool {
+	for _, item := range o.Items {
+		if item.ActiveQuantity() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// 환불 금액과 같도록 할인과 세금을 반영한 금액을 기록하고,
+// 마지막 상품이 취소되면 배송비와 끝전을 포함해 남은 금액을 모두 기록
+func (o *Order) cancelItem(item *OrderItem, quantity int, canceledAt time.Time) *OrderCancellation {
+	amount := o.RefundAmountFor(item.ProductNumber, quantity)
+	item.CanceledQuantity += quantity
+	if !o.hasActiveItems() {
+		amount = o.ActiveAmount()
+	}
+	o.CanceledAmount += amount
+	o.Cancellations = append(o.Cancellations, OrderCancellation{
+		OrderID:       o.ID,
+		ProductNumber: item.ProductNumber,
+		Quantity:      quantity,
+		Amount:        amount,
+		CanceledAt:    canceledAt,
+	})
+	return &o.Cancellations[len(o.Cancellations)-1]
+}
+
+func (o *Order) ChangeStatus(status OrderStatus) error {
+	switch status {
+	case OrderStatusPaid:
+		return o.MarkPaid()
+	case OrderStatusShipped:
+		return o.Ship()
+	case OrderStatusDelivered:
+		return o.Deliver()
+	}
+	return errors.New("변경할 수 없는 주문 상태입니다.")
+}
+
+func (i *OrderItem) Validate() error {
+	if i.ProductNumber == "" {
+		return errors.New("상품번호가 누락되었습니다.")
+	}
+	if i.Quantity <= 0 {
+		return errors.New("수량이 잘못되었습니다.")
+	}
 	return nil
 }
+
+func (i *OrderItem) ActiveQuantity() int {
+	return i.Quantity - i.CanceledQuantity
+}
+
+func (i *OrderItem) AddedTax() int64 {
+	if i.TaxInclusive {
+		return 0
+	}
+	return i.TaxAmount
+}
+
+func (i *OrderItem) ReturnableQuantity() int {
+	return i.ActiveQuantity() - i.ReturnedQuantity
+}
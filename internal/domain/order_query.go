@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type OrderSort string
+
+const (
+	OrderSortLatest OrderSort = "latest" // 최신 주문 순
+	OrderSortOldest OrderSort = "oldest" // 오래된 주문 순
+)
+
+const (
+	DefaultOrderPageSize = 20
+	MaxOrderPageSize     = 100
+)
+
+// OrderQuery 는 주문 목록 조회 조건입니다.
+// Cursor 는 이전 페이지의 마지막 주문 ID 이며, 0 이면 첫 페이지를 조회합니다.
+type OrderQuery struct {
+	MemberNumber string        // 회원번호
+	Statuses     []OrderStatus // 주문상태 (비어 있으면 전체)
+	From         *time.Time    // 주문일시 시작 (포함)
+	To           *time.Time    // 주문일시 종료 (미포함)
+	Sort         OrderSort     // 정렬 순서
+	Cursor       int           // 이전 페이지의 마지막 주문 ID
+	Limit        int           // 페이지 크기
+}
+
+func ParseOrderSort(sort string) (OrderSort, error) {
+	switch OrderSort(sort) {
+	case "":
+		return OrderSortLatest, nil
+	case OrderSortLatest, OrderSortOldest:
+		return OrderSort(sort), nil
+	}
+	return "", errors.New("유효하지 않은 정렬 순서입니다.")
+}
+
+func (q *OrderQuery) Validate() error {
+	if q.Limit < 0 || q.Limit > MaxOrderPageSize {
+		return errors.New("페이지 크기가 잘못되었습니다.")
+	}
+	if q.Cursor < 0 {
+		return errors.New("유효하지 않은 커서입니다.")
+	}
+	if q.From != nil && q.To != nil && !q.From.Before(*q.To) {
+		return errors.New("조회 시작일은 종료일보다 이전이어야 합니다.")
+	}
+	if _, err := ParseOrderSort(string(q.Sort)); err != nil {
+		return err
+	}
+	for _, status := range q.Statuses {
+		if _, err := ParseOrderStatus(string(status)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *OrderQuery) PageSize() int {
+	if q.Limit == 0 {
+		return DefaultOrderPageSize
+	}
+	return q.Limit
+}
+
+func (q *OrderQuery) IsOldestFirst() bool {
+	return q.Sort == OrderSortOldest
+}
+
+type OrderSearchSort string
+
+const (
+	OrderSearchSortLatest     OrderSearchSort = "latest"      // 최신 주문 순
+	OrderSearchSortOldest     OrderSearchSort = "oldest"      // 오래된 주문 순
+	OrderSearchSortAmountDesc OrderSearchSort = "amount_desc" // 주문 금액 높은 순
+	OrderSearchSortAmountAsc  OrderSearchSort = "amount_asc"  // 주문 금액 낮은 순
+)
+
+// OrderSearchCriteria 는 관리자 주문 검색 조건입니다.
+// Page 는 1부터 시작하며, 0 이면 첫 페이지를 조회합니다.
+type OrderSearchCriteria struct {
+	OrderNumber   string          // 주문번호
+	MemberNumber  string          // 회원번호
+	ProductNumber string          // 주문에 포함된 상품번호
+	Statuses      []OrderStatus   // 주문상태 (비어 있으면 전체)
+	From          *time.Time      // 주문일시 시작 (포함)
+	To            *time.Time      // 주문일시 종료 (미포함)
+	Sort          OrderSearchSort // 정렬 순서
+	Page          int             // 페이지 번호
+	Size          int             // 페이지 크기
+}
+
+func ParseOrderSearchSort(sort string) (OrderSearchSort, error) {
+	switch OrderSearchSort(sort) {
+	case "":
+		return OrderSearchSortLatest, nil
+	case OrderSearchSortLatest, OrderSearchSortOldest, OrderSearchSortAmountDesc, OrderSearchSortAmountAsc:
+		return OrderSearchSort(sort), nil
+	}
+	return "", errors.New("유효하지 않은 정렬 순서입니다.")
+}
+
+func (c *OrderSearchCriteria) Validate() error {
+	if c.Size < 0 || c.Size > MaxOrderPageSize {
+		return errors.New("페이지 크기가 잘못되었습니다.")
+	}
+	if c.Page < 0 {
+		return errors.New("페이지 번호가 잘못되었습니다.")
+	}
+	if c.From != nil && c.To != nil && !c.From.Before(*c.To) {
+		return errors.New("조회 시작일은 종료일보다 이전이어야 합니다.")
+	}
+	if _, err := ParseOrderSearchSort(string(c.Sort)); err != nil {
+		return err
+	}
+	for _, status := range c.Statuses {
+		if _, err := ParseOrderStatus(string(status)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OrderSearchCriteria) CurrentPage() int {
+	if c.Page == 0 {
+		return 1
+	}
+	return c.Page
+}
+
+func (c *OrderSearchCriteria) PageSize() int {
+	if c.Size == 0 {
+		return DefaultOrderPageSize
+	}
+	return c.Size
+}
+
+func (c *OrderSearchCriteria) Offset() int {
+	return (c.CurrentPage() - 1) * c.PageSize()
+}
@@ -0,0 +1,112 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderQuery_Validate_Success(t *testing.T) {
+	// Given
+	from := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	query := &domain.OrderQuery{
+		MemberNumber: "M12345",
+		Statuses:     []domain.OrderStatus{domain.OrderStatusPaid},
+		From:         &from,
+		To:           &to,
+		Sort:         domain.OrderSortOldest,
+		Limit:        50,
+	}
+
+	// When
+	err := query.Validate()
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 50, query.PageSize())
+	assert.True(t, query.IsOldestFirst())
+}
+
+func TestOrderQuery_Validate_Failure_InvalidDateRange(t *testing.T) {
+	// Given
+	from := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	query := &domain.OrderQuery{From: &from, To: &to}
+
+	// When
+	err := query.Validate()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "조회 시작일은 종료일보다 이전이어야 합니다.", err.Error())
+}
+
+func TestOrderQuery_Validate_Failure_LimitTooLarge(t *testing.T) {
+	// Given
+	query := &domain.OrderQuery{Limit: domain.MaxOrderPageSize + 1}
+
+	// When
+	err := query.Validate()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "페이지 크기가 잘못되었습니다.", err.Error())
+}
+
+func TestOrderQuery_PageSize_Default(t *testing.T) {
+	// Given
+	query := &domain.OrderQuery{}
+
+	// When
+	pageSize := query.PageSize()
+
+	// Then
+	assert.Equal(t, domain.DefaultOrderPageSize, pageSize)
+	assert.False(t, query.IsOldestFirst())
+}
+
+func TestOrderSearchCriteria_Validate_Success(t *testing.T) {
+	// Given
+	criteria := &domain.OrderSearchCriteria{
+		MemberNumber: "M12345",
+		Statuses:     []domain.OrderStatus{domain.OrderStatusShipped},
+		Sort:         domain.OrderSearchSortAmountDesc,
+		Page:         3,
+		Size:         10,
+	}
+
+	// When
+	err := criteria.Validate()
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 20, criteria.Offset())
+}
+
+func TestOrderSearchCriteria_Validate_Failure_InvalidSort(t *testing.T) {
+	// Given
+	criteria := &domain.OrderSearchCriteria{Sort: "unknown"}
+
+	// When
+	err := criteria.Validate()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "유효하지 않은 정렬 순서입니다.", err.Error())
+}
+
+func TestOrderSearchCriteria_Offset_Default(t *testing.T) {
+	// Given
+	criteria := &domain.OrderSearchCriteria{}
+
+	// When
+	offset := criteria.Offset()
+
+	// Then
+	assert.Equal(t, 0, offset)
+	assert.Equal(t, 1, criteria.CurrentPage())
+	assert.Equal(t, domain.DefaultOrderPageSize, criteria.PageSize())
+}
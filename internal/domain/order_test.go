@@ -10,14 +10,14 @@ import (
 func TestOrder_Validate_Success(t *testing.T) {
 	// Given
 	order := &domain.Order{
-		ID:            12345,
-		OrderDate:     time.Now(),
-		OrderNumber:   "O12345",
-		MemberNumber:  "M12345",
-		ProductNumber: "P12345",
-		Quantity:      2,
-		Price:         1000,
-		TotalAmount:   3000,
+		ID:           12345,
+		OrderDate:    time.Now(),
+		OrderNumber:  "O12345",
+		MemberNumber: "M12345",
+		Items: []domain.OrderItem{
+			{ProductNumber: "P12345", Quantity: 2},
+			{ProductNumber: "P12346", Quantity: 1},
+		},
 	}
 
 	// When
@@ -30,11 +30,9 @@ func TestOrder_Validate_Success(t *testing.T) {
 func TestOrder_Validate_Failure_MissingFields(t *testing.T) {
 	// Given
 	order := &domain.Order{
-		OrderNumber:   "",
-		MemberNumber:  "",
-		ProductNumber: "",
-		Quantity:      -1,
-		Price:         -1000,
+		OrderNumber:  "",
+		MemberNumber: "",
+		Items:        []domain.OrderItem{{ProductNumber: "", Quantity: -1}},
 	}
 
 	// When
@@ -45,11 +43,44 @@ func TestOrder_Validate_Failure_MissingFields(t *testing.T) {
 	assert.Equal(t, "주문번호가 누락되었습니다.", err.Error())
 }
 
+func TestOrder_Validate_Failure_NoItems(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+	}
+
+	// When
+	err := order.Validate()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "주문 상품이 누락되었습니다.", err.Error())
+}
+
+func TestOrder_Validate_Failure_InvalidItemQuantity(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Quantity: 0}},
+	}
+
+	// When
+	err := order.Validate()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "수량이 잘못되었습니다.", err.Error())
+}
+
 func TestOrder_Cancel_Success(t *testing.T) {
 	// Given
 	order := &domain.Order{
 		OrderNumber: "O12345",
-		IsCanceled:  false,
+		Status:      domain.OrderStatusPending,
 	}
 
 	// When
@@ -57,15 +88,287 @@ func TestOrder_Cancel_Success(t *testing.T) {
 
 	// Then
 	assert.NoError(t, err)
-	assert.Equal(t, true, order.IsCanceled)
+	assert.Equal(t, domain.OrderStatusCanceled, order.Status)
+	assert.NotNil(t, order.CanceledAt)
+	assert.Equal(t, domain.CancelReasonCustomerRequest, order.CancelReason)
+}
+
+func TestOrder_CancelByAdmin_Success(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber:  "O12345",
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPaid,
+	}
+
+	// When
+	err := order.CancelByAdmin("ADMIN1", domain.CancelReasonFraud, "도용된 카드로 결제된 주문입니다.")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusCanceled, order.Status)
+	assert.Equal(t, "ADMIN1", order.CanceledBy)
+	assert.Equal(t, domain.CancelReasonFraud, order.CancelReason)
+	assert.Equal(t, "도용된 카드로 결제된 주문입니다.", order.CancelNote)
+	assert.EqualValues(t, 2000, order.CanceledAmount)
+}
+
+func TestOrder_CancelByAdmin_Failure_MissingNote(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber: "O12345",
+		Status:      domain.OrderStatusPaid,
+	}
+
+	// When
+	err := order.CancelByAdmin("ADMIN1", domain.CancelReasonOutOfStock, " ")
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "취소 사유 상세가 누락되었습니다.", err.Error())
+	assert.Equal(t, domain.OrderStatusPaid, order.Status)
+}
+
+func TestOrder_PartialCancel_Success(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber: "O12345",
+		Items: []domain.OrderItem{
+			{ProductNumber: "P12345", Price: 1000, Quantity: 3, LineTotal: 3000},
+			{ProductNumber: "P12346", Price: 500, Quantity: 1, LineTotal: 500},
+		},
+		TotalAmount: 3500,
+		Status:      domain.OrderStatusPaid,
+	}
+
+	// When
+	cancellation, err := order.PartialCancel("P12345", 2)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2000, cancellation.Amount)
+	assert.Equal(t, 1, order.Items[0].ActiveQuantity())
+	assert.EqualValues(t, 2000, order.CanceledAmount)
+	assert.EqualValues(t, 1500, order.ActiveAmount())
+	assert.Equal(t, domain.OrderStatusPaid, order.Status)
+	assert.Len(t, order.Cancellations, 1)
+}
+
+func TestOrder_PartialCancel_Success_AllItemsCanceled(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber: "O12345",
+		Items:       []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount: 2000,
+		Status:      domain.OrderStatusPaid,
+	}
+	_, _ = order.PartialCancel("P12345", 1)
+
+	// When
+	_, err := order.PartialCancel("P12345", 1)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusCanceled, order.Status)
 	assert.NotNil(t, order.CanceledAt)
 }
 
+func TestOrder_PartialCancel_Success_RecordsRefundAmount(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber: "O12345",
+		Items: []domain.OrderItem{
+			{ProductNumber: "P12345", Price: 1000, Quantity: 3, LineTotal: 3000, DiscountAmount: 300, TaxAmount: 270},
+			{ProductNumber: "P12346", Price: 500, Quantity: 1, LineTotal: 500},
+		},
+		TotalAmount: 6470,
+		Status:      domain.OrderStatusPaid,
+	}
+
+	// When
+	cancellation, err := order.PartialCancel("P12345", 2)
+	_, _ = order.PartialCancel("P12345", 1)
+	last, lastErr := order.PartialCancel("P12346", 1)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, order.RefundAmountFor("P12345", 2), cancellation.Amount)
+	assert.EqualValues(t, 1980, cancellation.Amount)
+	assert.NoError(t, lastErr)
+	assert.EqualValues(t, 3500, last.Amount)
+	assert.EqualValues(t, 6470, order.CanceledAmount)
+	assert.Equal(t, domain.OrderStatusCanceled, order.Status)
+}
+
+func TestOrder_PartialCancel_Failure_ExceedsQuantity(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber: "O12345",
+		Items:       []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, CanceledQuantity: 1, LineTotal: 2000}},
+		TotalAmount: 2000,
+		Status:      domain.OrderStatusPaid,
+	}
+
+	// When
+	_, err := order.PartialCancel("P12345", 2)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "취소 수량이 잘못되었습니다.", err.Error())
+}
+
+func TestOrder_PartialCancel_Failure_UnknownProduct(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber: "O12345",
+		Items:       []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		Status:      domain.OrderStatusPaid,
+	}
+
+	// When
+	_, err := order.PartialCancel("P99999", 1)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "주문에 없는 상품입니다.", err.Error())
+}
+
+func TestOrder_Cancel_Success_AfterPartialCancel(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber: "O12345",
+		Items:       []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 3, LineTotal: 3000}},
+		TotalAmount: 3000,
+		Status:      domain.OrderStatusPaid,
+	}
+	_, _ = order.PartialCancel("P12345", 1)
+
+	// When
+	err := order.Cancel()
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, order.Cancellations, 2)
+	assert.Equal(t, 2, order.Cancellations[1].Quantity)
+	assert.EqualValues(t, 3000, order.CanceledAmount)
+}
+
+func TestOrder_RequestReturn_Success(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 3, LineTotal: 3000}},
+		TotalAmount:  3000,
+		Status:       domain.OrderStatusDelivered,
+	}
+
+	// When
+	returnRequest, err := order.RequestReturn("P12345", 2, "파손")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 12345, returnRequest.OrderID)
+	assert.EqualValues(t, 2000, returnRequest.Amount)
+	assert.Equal(t, domain.ReturnStatusRequested, returnRequest.Status)
+	assert.Equal(t, 1, order.Items[0].ReturnableQuantity())
+}
+
+func TestOrder_RequestReturn_Failure_NotDelivered(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		ID:          12345,
+		OrderNumber: "O12345",
+		Items:       []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 3, LineTotal: 3000}},
+		Status:      domain.OrderStatusShipped,
+	}
+
+	// When
+	_, err := order.RequestReturn("P12345", 1, "파손")
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "배송 완료된 주문만 반품할 수 있습니다.", err.Error())
+}
+
+func TestOrder_RequestReturn_Failure_ExceedsReturnableQuantity(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		ID:          12345,
+		OrderNumber: "O12345",
+		Items:       []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 3, ReturnedQuantity: 2, LineTotal: 3000}},
+		Status:      domain.OrderStatusDelivered,
+	}
+
+	// When
+	_, err := order.RequestReturn("P12345", 2, "파손")
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "반품 수량이 잘못되었습니다.", err.Error())
+}
+
+func TestOrder_ApplyPriceBreakdown_Success(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber: "O12345",
+		Items:       []domain.OrderItem{{ProductNumber: "P12345", Quantity: 4}},
+		Status:      domain.OrderStatusPending,
+	}
+	breakdown := &domain.PriceBreakdown{
+		Items: []domain.PriceBreakdownItem{{
+			ProductNumber:  "P12345",
+			ProductName:    "Pizza",
+			Price:          1000,
+			Quantity:       4,
+			BaseAmount:     4000,
+			DiscountAmount: 400,
+			TaxAmount:      360,
+		}},
+		CouponCode:  "WELCOME10",
+		ShippingFee: 3000,
+	}
+	breakdown.Summarize()
+
+	// When
+	err := order.ApplyPriceBreakdown(breakdown)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4000, order.TotalAmount)
+	assert.EqualValues(t, 400, order.DiscountAmount)
+	assert.Equal(t, "WELCOME10", order.CouponCode)
+	assert.EqualValues(t, 6960, order.PaymentAmount())
+	assert.EqualValues(t, breakdown.TotalAmount, order.PaymentAmount())
+	assert.EqualValues(t, 990, order.RefundAmountFor("P12345", 1))
+}
+
+func TestOrder_ApplyPriceBreakdown_Failure_MismatchedItems(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber: "O12345",
+		Items:       []domain.OrderItem{{ProductNumber: "P12345", Quantity: 4}},
+	}
+	breakdown := &domain.PriceBreakdown{
+		Items: []domain.PriceBreakdownItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, BaseAmount: 2000}},
+	}
+
+	// When
+	err := order.ApplyPriceBreakdown(breakdown)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "주문 상품과 가격 정보가 일치하지 않습니다.", err.Error())
+}
+
 func TestOrder_Cancel_Failure_AlreadyCanceled(t *testing.T) {
 	// Given
 	order := &domain.Order{
 		OrderNumber: "O12345",
-		IsCanceled:  true,
+		Status:      domain.OrderStatusCanceled,
 	}
 
 	// When
@@ -75,3 +378,108 @@ func TestOrder_Cancel_Failure_AlreadyCanceled(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "이미 취소된 주문입니다.", err.Error())
 }
+
+func TestOrder_Cancel_Failure_AlreadyShipped(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber: "O12345",
+		Status:      domain.OrderStatusShipped,
+	}
+
+	// When
+	err := order.Cancel()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "배송이 시작된 주문은 취소할 수 없습니다.", err.Error())
+}
+
+func TestOrder_Transitions_Success(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber: "O12345",
+		Status:      domain.OrderStatusPending,
+	}
+
+	// When
+	errPaid := order.MarkPaid()
+	errShipped := order.Ship()
+	errDelivered := order.Deliver()
+
+	// Then
+	assert.NoError(t, errPaid)
+	assert.NoError(t, errShipped)
+	assert.NoError(t, errDelivered)
+	assert.Equal(t, domain.OrderStatusDelivered, order.Status)
+	assert.NotNil(t, order.PaidAt)
+	assert.NotNil(t, order.ShippedAt)
+	assert.NotNil(t, order.DeliveredAt)
+}
+
+func TestOrder_Ship_Failure_NotPaid(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber: "O12345",
+		Status:      domain.OrderStatusPending,
+	}
+
+	// When
+	err := order.Ship()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "결제 완료된 주문만 배송할 수 있습니다.", err.Error())
+	assert.Nil(t, order.ShippedAt)
+}
+
+func TestOrder_ChangeStatus_Failure_Canceled(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		OrderNumber: "O12345",
+		Status:      domain.OrderStatusPending,
+	}
+
+	// When
+	err := order.ChangeStatus(domain.OrderStatusCanceled)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "변경할 수 없는 주문 상태입니다.", err.Error())
+}
+
+func TestParseOrderStatus_Failure_Unknown(t *testing.T) {
+	// When
+	_, err := domain.ParseOrderStatus("lost")
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "유효하지 않은 주문 상태입니다.", err.Error())
+}
+
+func TestOrder_PaymentAmount_TaxInclusive(t *testing.T) {
+	// Given
+	order := &domain.Order{
+		Items: []domain.OrderItem{
+			{ProductNumber: "P12345", Quantity: 1},
+			{ProductNumber: "P12346", Quantity: 1},
+		},
+	}
+	breakdown := &domain.PriceBreakdown{
+		Items: []domain.PriceBreakdownItem{
+			{ProductNumber: "P12345", Price: 1000, Quantity: 1, BaseAmount: 1000, TaxAmount: 100},
+			{ProductNumber: "P12346", Price: 1080, Quantity: 1, BaseAmount: 1080, TaxAmount: 80, TaxInclusive: true},
+		},
+	}
+	breakdown.Summarize()
+
+	// When
+	err := order.ApplyPriceBreakdown(breakdown)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 180, order.TaxAmount)
+	assert.EqualValues(t, 80, order.IncludedTax)
+	assert.EqualValues(t, 2180, order.PaymentAmount())
+	assert.EqualValues(t, breakdown.TotalAmount, order.PaymentAmount())
+	assert.EqualValues(t, 1080, order.RefundAmountFor("P12346", 1))
+}
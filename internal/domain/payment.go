@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentStatusAuthorized        PaymentStatus = "authorized"         // 승인
+	PaymentStatusCaptured          PaymentStatus = "captured"           // 매입
+	PaymentStatusPartiallyRefunded PaymentStatus = "partially_refunded" // 부분 환불
+	PaymentStatusRefunded          PaymentStatus = "refunded"           // 환불
+)
+
+type Payment struct {
+	ID             int           `gorm:"primaryKey;autoIncrement" json:"id"`        // 기본 키
+	OrderID        int           `gorm:"uniqueIndex;not null" json:"order_id"`      // 주문 기본 키
+	Provider       string        `gorm:"not null" json:"provider"`                  // 결제 대행사
+	TransactionID  string        `gorm:"not null" json:"transaction_id"`            // 결제 대행사 거래번호
+	Amount         int64         `gorm:"not null" json:"amount"`                    // 결제 금액
+	RefundedAmount int64         `gorm:"not null;default:0" json:"refunded_amount"` // 환불 금액
+	PendingRefund  int64         `gorm:"not null;default:0" json:"pending_refund"`  // 대행사 반영 대기 중인 환불 금액
+	Status         PaymentStatus `gorm:"type:varchar(20);not null" json:"status"`   // 결제상태
+	AuthorizedAt   time.Time     `gorm:"not null" json:"authorized_at"`             // 승인일
+	CapturedAt     *time.Time    `json:"captured_at,omitempty"`                     // 매입일
+	RefundedAt     *time.Time    `json:"refunded_at,omitempty"`                     // 환불일
+}
+
+func NewAuthorizedPayment(provider string, transactionID string, amount int64) *Payment {
+	return &Payment{
+		Provider:      provider,
+		TransactionID: transactionID,
+		Amount:        amount,
+		Status:        PaymentStatusAuthorized,
+		AuthorizedAt:  time.Now(),
+	}
+}
+
+// Capture 는 승인된 결제를 매입합니다. 배송 전에 부분 취소된 결제도 매입할 수 있으며, 이때는 부분 환불 상태를 유지합니다.
+func (p *Payment) Capture() error {
+	if p.CapturedAt != nil || (p.Status != PaymentStatusAuthorized && p.Status != PaymentStatusPartiallyRefunded) {
+		return errors.New("승인된 결제만 매입할 수 있습니다.")
+	}
+	now := time.Now()
+	if p.Status == PaymentStatusAuthorized {
+		p.Status = PaymentStatusCaptured
+	}
+	p.CapturedAt = &now
+	return nil
+}
+
+func (p *Payment) IsCaptured() bool {
+	return p.CapturedAt != nil
+}
+
+func (p *Payment) RefundableAmount() int64 {
+	return p.Amount - p.RefundedAmount
+}
+
+// Refund 는 환불을 기록하고 대행사 반영 전까지 대기 금액으로 남겨 둡니다.
+func (p *Payment) Refund(amount int64) error {
+	if amount <= 0 {
+		return errors.New("환불 금액이 잘못되었습니다.")
+	}
+	if p.Status == PaymentStatusRefunded {
+		return errors.New("이미 환불된 결제입니다.")
+	}
+	if amount > p.RefundableAmount() {
+		return errors.New("환불 가능 금액을 초과했습니다.")
+	}
+	now := time.Now()
+	p.RefundedAmount += amount
+	p.PendingRefund += amount
+	p.RefundedAt = &now
+	if p.RefundableAmount() == 0 {
+		p.Status = PaymentStatusRefunded
+	} else {
+		p.Status = PaymentStatusPartiallyRefunded
+	}
+	return nil
+}
+
+// CompleteRefund 는 대행사에 반영된 환불 금액을 대기 금액에서 제외합니다.
+func (p *Payment) CompleteRefund(amount int64) error {
+	if amount <= 0 || amount > p.PendingRefund {
+		return errors.New("반영 대기 중인 환불 금액을 초과했습니다.")
+	}
+	p.PendingRefund -= amount
+	return nil
+}
@@ -0,0 +1,130 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuthorizedPayment_Success(t *testing.T) {
+	// When
+	payment := domain.NewAuthorizedPayment("fake", "FAKE-O12345", 3000)
+
+	// Then
+	assert.Equal(t, domain.PaymentStatusAuthorized, payment.Status)
+	assert.EqualValues(t, 3000, payment.Amount)
+	assert.False(t, payment.AuthorizedAt.IsZero())
+}
+
+func TestPayment_Capture_Success(t *testing.T) {
+	// Given
+	payment := domain.NewAuthorizedPayment("fake", "FAKE-O12345", 3000)
+
+	// When
+	err := payment.Capture()
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PaymentStatusCaptured, payment.Status)
+	assert.NotNil(t, payment.CapturedAt)
+}
+
+func TestPayment_Capture_Failure_AlreadyCaptured(t *testing.T) {
+	// Given
+	payment := domain.NewAuthorizedPayment("fake", "FAKE-O12345", 3000)
+	_ = payment.Capture()
+
+	// When
+	err := payment.Capture()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "승인된 결제만 매입할 수 있습니다.", err.Error())
+}
+
+func TestPayment_Capture_Success_AfterPartialRefund(t *testing.T) {
+	// Given
+	payment := domain.NewAuthorizedPayment("fake", "FAKE-O12345", 3000)
+	_ = payment.Refund(1000)
+
+	// When
+	err := payment.Capture()
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PaymentStatusPartiallyRefunded, payment.Status)
+	assert.NotNil(t, payment.CapturedAt)
+	assert.EqualValues(t, 2000, payment.RefundableAmount())
+	assert.Error(t, payment.Capture())
+}
+
+func TestPayment_Refund_Success_Partial(t *testing.T) {
+	// Given
+	payment := domain.NewAuthorizedPayment("fake", "FAKE-O12345", 3000)
+
+	// When
+	err := payment.Refund(1000)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PaymentStatusPartiallyRefunded, payment.Status)
+	assert.EqualValues(t, 2000, payment.RefundableAmount())
+	assert.EqualValues(t, 1000, payment.PendingRefund)
+}
+
+func TestPayment_Refund_Success_Full(t *testing.T) {
+	// Given
+	payment := domain.NewAuthorizedPayment("fake", "FAKE-O12345", 3000)
+	_ = payment.Refund(1000)
+
+	// When
+	err := payment.Refund(2000)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PaymentStatusRefunded, payment.Status)
+	assert.EqualValues(t, 0, payment.RefundableAmount())
+	assert.NotNil(t, payment.RefundedAt)
+}
+
+func TestPayment_Refund_Failure_ExceedsRefundable(t *testing.T) {
+	// Given
+	payment := domain.NewAuthorizedPayment("fake", "FAKE-O12345", 3000)
+
+	// When
+	err := payment.Refund(5000)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "환불 가능 금액을 초과했습니다.", err.Error())
+	assert.EqualValues(t, 0, payment.RefundedAmount)
+}
+
+func TestPayment_CompleteRefund_Success(t *testing.T) {
+	// Given
+	payment := domain.NewAuthorizedPayment("fake", "FAKE-O12345", 3000)
+	_ = payment.Refund(1000)
+
+	// When
+	err := payment.CompleteRefund(1000)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, payment.PendingRefund)
+	assert.EqualValues(t, 1000, payment.RefundedAmount)
+}
+
+func TestPayment_CompleteRefund_Failure_ExceedsPending(t *testing.T) {
+	// Given
+	payment := domain.NewAuthorizedPayment("fake", "FAKE-O12345", 3000)
+	_ = payment.Refund(1000)
+
+	// When
+	err := payment.CompleteRefund(2000)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "반영 대기 중인 환불 금액을 초과했습니다.", err.Error())
+	assert.EqualValues(t, 1000, payment.PendingRefund)
+}
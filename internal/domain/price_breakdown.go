@@ -0,0 +1,49 @@
+package domain
+
+type PriceBreakdown struct {
+	Items          []PriceBreakdownItem // 상품별 금액
+	CouponCode     string               // 적용 쿠폰 코드
+	BaseAmount     int64                // 상품 금액 합계
+	DiscountAmount int64                // 할인 금액 합계
+	TaxAmount      int64                // 세금 합계
+	IncludedTax    int64                // 가격에 포함된 세금 합계
+	ShippingFee    int64                // 배송비
+	TotalAmount    int64                // 최종 결제 금액
+}
+
+type PriceBreakdownItem struct {
+	ProductNumber  string            // 상품번호
+	ProductName    string            // 상품명
+	SKU            string            // 재고 관리 코드 (옵션 상품인 경우)
+	OptionValues   map[string]string // 옵션 값 (옵션 상품인 경우)
+	Price          int64             // 단가
+	Quantity       int               // 수량
+	BaseAmount     int64             // 상품 금액
+	DiscountAmount int64             // 할인 금액
+	TaxAmount      int64             // 세금
+	TaxInclusive   bool              // 세금 포함 가격 여부
+	TotalAmount    int64             // 합계
+}
+
+func (b *PriceBreakdown) Summarize() {
+	b.BaseAmount, b.DiscountAmount, b.TaxAmount, b.IncludedTax = 0, 0, 0, 0
+	for i := range b.Items {
+		item := &b.Items[i]
+		item.TotalAmount = item.BaseAmount - item.DiscountAmount + item.AddedTax()
+		b.BaseAmount += item.BaseAmount
+		b.DiscountAmount += item.DiscountAmount
+		b.TaxAmount += item.TaxAmount
+		if item.TaxInclusive {
+			b.IncludedTax += item.TaxAmount
+		}
+	}
+	b.TotalAmount = b.BaseAmount - b.DiscountAmount + b.TaxAmount - b.IncludedTax + b.ShippingFee
+}
+
+// 세금 포함 가격이면 이미 금액에 포함되어 있으므로 추가되는 세금이 없음
+func (i *PriceBreakdownItem) AddedTax() int64 {
+	if i.TaxInclusive {
+		return 0
+	}
+	return i.TaxAmount
+}
@@ -2,17 +2,36 @@ package domain
 
 import (
 	"errors"
+	"time"
 
 	"gorm.io/gorm"
 )
 
+var (
+	ErrProductArchived   = errors.New("판매가 중단된 상품입니다.")
+	ErrInsufficientStock = errors.New("재고 수량이 부족합니다.")
+	ErrStockConflict     = errors.New("재고 수량이 변경되었습니다. 다시 조회한 뒤 시도해 주세요.")
+)
+
 type Product struct {
-	ID            int    `gorm:"primaryKey;autoIncrement" json:"id"`                           // 기본 키
-	ProductNumber string `gorm:"unique;not null" json:"product_number"`                        // 상품번호
-	ProductName   string `gorm:"not null;index:idx_category_product_name" json:"product_name"` // 상품명
-	Category      string `gorm:"index:idx_category_product_name" json:"category"`              // 카테고리
-	Price         int64  `gorm:"not null" json:"price"`                                        // 가격
-	StockQuantity int    `gorm:"not null" json:"stock_quantity"`                               // 재고수량
+	ID                  int               `gorm:"primaryKey;autoIncrement" json:"id"`                               // 기본 키
+	ProductNumber       string            `gorm:"unique;not null" json:"product_number"`                            // 상품번호
+	ProductName         string            `gorm:"not null;index:idx_category_product_name" json:"product_name"`     // 상품명
+	CategoryID          *int              `gorm:"index" json:"category_id,omitempty"`                               // 카테고리 기본 키
+	Category            string            `gorm:"index:idx_category_product_name" json:"category"`                  // 카테고리 슬러그
+	Description         string            `gorm:"type:text" json:"description"`                                     // 상품 설명
+	Price               int64             `gorm:"not null" json:"price"`                                            // 가격
+	PriceOverride       *int64            `json:"price_override,omitempty"`                                         // 옵션 상품 전용 가격 (없으면 상위 상품 가격을 따름)
+	StockQuantity       int               `gorm:"not null" json:"stock_quantity"`                                   // 재고수량
+	Weight              int               `gorm:"not null;default:0" json:"weight"`                                 // 무게 (g)
+	Width               int               `gorm:"not null;default:0" json:"width"`                                  // 가로 (cm)
+	Length              int               `gorm:"not null;default:0" json:"length"`                                 // 세로 (cm)
+	Height              int               `gorm:"not null;default:0" json:"height"`                                 // 높이 (cm)
+	ArchivedAt          *time.Time        `gorm:"index" json:"archived_at,omitempty"`                               // 보관 처리일 (판매 중단)
+	ParentProductNumber string            `gorm:"index;not null;default:''" json:"parent_product_number,omitempty"` // 상위 상품번호 (옵션 상품인 경우)
+	SKU                 string            `gorm:"index;not null;default:''" json:"sku,omitempty"`                   // 재고 관리 코드 (옵션 상품인 경우)
+	Options             []ProductOption   `gorm:"type:text;serializer:json" json:"options,omitempty"`               // 옵션 정의 (상위 상품인 경우)
+	OptionValues        map[string]string `gorm:"type:text;serializer:json" json:"option_values,omitempty"`         // 선택된 옵션 값 (옵션 상품인 경우)
 }
 
 func (p *Product) Validate() error {
@@ -28,7 +47,33 @@ func (p *Product) Validate() error {
 	if p.StockQuantity < 0 {
 		return errors.New("재고 수량은 음수일 수 없습니다.")
 	}
-	return nil
+	if p.Weight < 0 || p.Width < 0 || p.Length < 0 || p.Height < 0 {
+		return errors.New("상품 무게와 크기는 음수일 수 없습니다.")
+	}
+	return p.validateOptions()
+}
+
+// AssignCategory 는 카테고리를 지정합니다. 과세 분류, 쿠폰, 취소 정책이 슬러그로 카테고리를 찾으므로 슬러그도 함께 저장합니다.
+func (p *Product) AssignCategory(category *Category) {
+	p.CategoryID = &category.ID
+	p.Category = category.Slug
+}
+
+// ChangePrice 는 가격을 변경하고, 실제로 바뀐 경우 가격 변경 이력을 반환합니다.
+func (p *Product) ChangePrice(price int64, changedBy string) (*ProductPriceChange, error) {
+	if price == p.Price {
+		return nil, nil
+	}
+	change, err := NewProductPriceChange(p.ProductNumber, p.Price, price, changedBy)
+	if err != nil {
+		return nil, err
+	}
+	p.Price = price
+	// 옵션 상품의 가격을 바꾸면 이후 상위 상품 가격과 관계없이 이 가격을 유지
+	if p.IsVariant() {
+		p.PriceOverride = &price
+	}
+	return change, nil
 }
 
 func (p *Product) UpdateStock(quantity int) error {
@@ -39,9 +84,42 @@ func (p *Product) UpdateStock(quantity int) error {
 	return nil
 }
 
+// Archive 는 상품을 목록과 주문 대상에서 제외합니다. 지난 주문에서는 계속 조회할 수 있습니다.
+func (p *Product) Archive(now time.Time) error {
+	if p.IsArchived() {
+		return errors.New("이미 보관 처리된 상품입니다.")
+	}
+	p.ArchivedAt = &now
+	return nil
+}
+
+func (p *Product) Restore() error {
+	if !p.IsArchived() {
+		return errors.New("보관 처리되지 않은 상품입니다.")
+	}
+	p.ArchivedAt = nil
+	return nil
+}
+
+func (p *Product) IsArchived() bool {
+	return p.ArchivedAt != nil
+}
+
+// CheckOrderable 은 장바구니 담기와 주문 생성 전에 판매 중인 상품인지 확인합니다.
+func (p *Product) CheckOrderable() error {
+	if p.IsArchived() {
+		return ErrProductArchived
+	}
+	if p.HasVariants() {
+		return errors.New("옵션 상품을 선택해 주세요.")
+	}
+	return nil
+}
+
+// CanBeDeleted 는 상품번호를 참조하는 주문 상품이 하나도 없을 때만 true를 반환합니다.
 func (p *Product) CanBeDeleted(db *gorm.DB) (bool, error) {
 	var count int64
-	if err := db.Model(&Order{}).Where("id = ?", p.ID).Count(&count).Error; err != nil {
+	if err := db.Model(&OrderItem{}).Where("product_number = ?", p.ProductNumber).Count(&count).Error; err != nil {
 		return false, err
 	}
 	return count == 0, nil
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ProductPriceChange 는 상품 가격 변경 이력이며, 한 번 기록되면 수정하지 않습니다.
+type ProductPriceChange struct {
+	ID            int       `gorm:"primaryKey;autoIncrement" json:"id"`        // 기본 키
+	ProductNumber string    `gorm:"index;not null" json:"product_number"`      // 상품번호
+	OldPrice      int64     `gorm:"not null" json:"old_price"`                 // 변경 전 가격
+	NewPrice      int64     `gorm:"not null" json:"new_price"`                 // 변경 후 가격
+	ChangedBy     string    `json:"changed_by"`                                // 변경한 관리자 회원번호
+	ChangedAt     time.Time `gorm:"not null;autoCreateTime" json:"changed_at"` // 변경일
+}
+
+func NewProductPriceChange(productNumber string, oldPrice, newPrice int64, changedBy string) (*ProductPriceChange, error) {
+	change := &ProductPriceChange{
+		ProductNumber: productNumber,
+		OldPrice:      oldPrice,
+		NewPrice:      newPrice,
+		ChangedBy:     changedBy,
+	}
+	if err := change.Validate(); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+func (c *ProductPriceChange) Validate() error {
+	if c.ProductNumber == "" {
+		return errors.New("상품번호가 누락되었습니다.")
+	}
+	if c.NewPrice <= 0 {
+		return errors.New("가격이 잘못되었습니다.")
+	}
+	if c.OldPrice == c.NewPrice {
+		return errors.New("변경 전후 가격이 같습니다.")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProductPriceChange_Success(t *testing.T) {
+	// When
+	change, err := domain.NewProductPriceChange("P12345", 1000, 1200, "ADMIN1")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), change.OldPrice)
+	assert.Equal(t, int64(1200), change.NewPrice)
+	assert.Equal(t, "ADMIN1", change.ChangedBy)
+}
+
+func TestNewProductPriceChange_Failure_InvalidPrice(t *testing.T) {
+	// When
+	change, err := domain.NewProductPriceChange("P12345", 1000, 0, "ADMIN1")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, change)
+	assert.Equal(t, "가격이 잘못되었습니다.", err.Error())
+}
+
+func TestNewProductPriceChange_Failure_SamePrice(t *testing.T) {
+	// When
+	change, err := domain.NewProductPriceChange("P12345", 1000, 1000, "ADMIN1")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, change)
+	assert.Equal(t, "변경 전후 가격이 같습니다.", err.Error())
+}
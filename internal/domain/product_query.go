@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type ProductSort string
+
+const (
+	ProductSortNewest    ProductSort = "newest"     // 최근 등록 순
+	ProductSortPriceAsc  ProductSort = "price_asc"  // 가격 낮은 순
+	ProductSortPriceDesc ProductSort = "price_desc" // 가격 높은 순
+	ProductSortRelevance ProductSort = "relevance"  // 검색어 관련도 순 (상품명 일치 > 상품명 시작 > 상품명 포함 > 설명 포함)
+)
+
+const (
+	DefaultProductPageSize = 20
+	MaxProductPageSize     = 100
+)
+
+var ErrInvalidProductCursor = errors.New("유효하지 않은 커서입니다.")
+
+// ProductQuery 는 상품 목록 조회 조건입니다.
+// 보관 처리된 상품과 옵션 상품은 항상 제외되며, 옵션이 있는 상품의 가격 조건과 정렬은 상위 상품 가격을 기준으로 합니다.
+type ProductQuery struct {
+	Keyword    string         // 검색어 (상품명, 상품 설명)
+	Categories []string       // 카테고리 슬러그 (비어 있으면 전체)
+	MinPrice   *int64         // 최소 가격 (포함)
+	MaxPrice   *int64         // 최대 가격 (포함)
+	InStock    bool           // 재고가 있는 상품만 조회 (옵션이 있는 상품은 재고가 있는 옵션 상품이 하나라도 있으면 포함)
+	Sort       ProductSort    // 정렬 순서
+	Cursor     *ProductCursor // 이전 페이지의 마지막 상품 위치 (nil 이면 첫 페이지)
+	Limit      int            // 페이지 크기
+}
+
+// ProductCursor 는 상품 목록의 키셋 페이지네이션 위치입니다.
+// Key 는 정렬 기준 값(최근 등록 순은 상품 ID, 가격 순은 가격, 관련도 순은 관련도 점수)이며, 같은 값 사이에서는 ID로 순서를 정합니다.
+type ProductCursor struct {
+	Sort ProductSort
+	Key  int64
+	ID   int
+}
+
+// ParseProductSort 는 정렬 순서를 변환합니다. 지정하지 않으면 검색어가 있을 때 관련도 순, 없을 때 최근 등록 순입니다.
+func ParseProductSort(sort, keyword string) (ProductSort, error) {
+	switch ProductSort(sort) {
+	case "":
+		if keyword != "" {
+			return ProductSortRelevance, nil
+		}
+		return ProductSortNewest, nil
+	case ProductSortNewest, ProductSortPriceAsc, ProductSortPriceDesc, ProductSortRelevance:
+		return ProductSort(sort), nil
+	}
+	return "", errors.New("유효하지 않은 정렬 순서입니다.")
+}
+
+func (q *ProductQuery) Validate() error {
+	if q.Limit < 0 || q.Limit > MaxProductPageSize {
+		return errors.New("페이지 크기가 잘못되었습니다.")
+	}
+	if (q.MinPrice != nil && *q.MinPrice < 0) || (q.MaxPrice != nil && *q.MaxPrice < 0) {
+		return errors.New("가격 범위가 잘못되었습니다.")
+	}
+	if q.MinPrice != nil && q.MaxPrice != nil && *q.MinPrice > *q.MaxPrice {
+		return errors.New("최소 가격은 최대 가격보다 클 수 없습니다.")
+	}
+	if _, err := ParseProductSort(string(q.Sort), q.Keyword); err != nil {
+		return err
+	}
+	if q.Sort == ProductSortRelevance && q.Keyword == "" {
+		return errors.New("관련도 순 정렬에는 검색어가 필요합니다.")
+	}
+	// 다른 정렬 순서로 발급된 커서는 위치를 나타낼 수 없음
+	if q.Cursor != nil && q.Cursor.Sort != q.Sort {
+		return ErrInvalidProductCursor
+	}
+	return nil
+}
+
+func (q *ProductQuery) PageSize() int {
+	if q.Limit == 0 {
+		return DefaultProductPageSize
+	}
+	return q.Limit
+}
+
+// Encode 는 커서를 응답의 next_cursor 로 내려줄 문자열로 변환합니다.
+func (c *ProductCursor) Encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%d:%d", c.Sort, c.Key, c.ID)))
+}
+
+func DecodeProductCursor(value string) (*ProductCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidProductCursor
+	}
+	parts := strings.Split(string(decoded), ":")
+	if len(parts) != 3 {
+		return nil, ErrInvalidProductCursor
+	}
+	key, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidProductCursor
+	}
+	id, err := strconv.Atoi(parts[2])
+	if err != nil || id <= 0 {
+		return nil, ErrInvalidProductCursor
+	}
+	return &ProductCursor{Sort: ProductSort(parts[0]), Key: key, ID: id}, nil
+}
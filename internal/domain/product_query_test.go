@@ -0,0 +1,115 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProductSort_Default(t *testing.T) {
+	// Given
+	keyword := "피자"
+
+	// When
+	withKeyword, errWithKeyword := domain.ParseProductSort("", keyword)
+	withoutKeyword, errWithoutKeyword := domain.ParseProductSort("", "")
+
+	// Then
+	assert.NoError(t, errWithKeyword)
+	assert.NoError(t, errWithoutKeyword)
+	assert.Equal(t, domain.ProductSortRelevance, withKeyword)
+	assert.Equal(t, domain.ProductSortNewest, withoutKeyword)
+}
+
+func TestProductQuery_Validate_Success(t *testing.T) {
+	// Given
+	minPrice, maxPrice := int64(1000), int64(5000)
+	query := &domain.ProductQuery{
+		Keyword:  "피자",
+		MinPrice: &minPrice,
+		MaxPrice: &maxPrice,
+		Sort:     domain.ProductSortPriceAsc,
+		Cursor:   &domain.ProductCursor{Sort: domain.ProductSortPriceAsc, Key: 2000, ID: 3},
+		Limit:    50,
+	}
+
+	// When
+	err := query.Validate()
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 50, query.PageSize())
+}
+
+func TestProductQuery_Validate_Failure_InvalidPriceRange(t *testing.T) {
+	// Given
+	minPrice, maxPrice := int64(5000), int64(1000)
+	query := &domain.ProductQuery{MinPrice: &minPrice, MaxPrice: &maxPrice, Sort: domain.ProductSortNewest}
+
+	// When
+	err := query.Validate()
+
+	// Then
+	assert.EqualError(t, err, "최소 가격은 최대 가격보다 클 수 없습니다.")
+}
+
+func TestProductQuery_Validate_Failure_RelevanceWithoutKeyword(t *testing.T) {
+	// Given
+	query := &domain.ProductQuery{Sort: domain.ProductSortRelevance}
+
+	// When
+	err := query.Validate()
+
+	// Then
+	assert.EqualError(t, err, "관련도 순 정렬에는 검색어가 필요합니다.")
+}
+
+func TestProductQuery_Validate_Failure_CursorFromOtherSort(t *testing.T) {
+	// Given
+	query := &domain.ProductQuery{
+		Sort:   domain.ProductSortPriceDesc,
+		Cursor: &domain.ProductCursor{Sort: domain.ProductSortPriceAsc, Key: 2000, ID: 3},
+	}
+
+	// When
+	err := query.Validate()
+
+	// Then
+	assert.ErrorIs(t, err, domain.ErrInvalidProductCursor)
+}
+
+func TestProductQuery_PageSize_Default(t *testing.T) {
+	// Given
+	query := &domain.ProductQuery{}
+
+	// When
+	pageSize := query.PageSize()
+
+	// Then
+	assert.Equal(t, domain.DefaultProductPageSize, pageSize)
+}
+
+func TestProductCursor_EncodeDecode(t *testing.T) {
+	// Given
+	cursor := &domain.ProductCursor{Sort: domain.ProductSortPriceDesc, Key: 15000, ID: 42}
+
+	// When
+	decoded, err := domain.DecodeProductCursor(cursor.Encode())
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+}
+
+func TestDecodeProductCursor_Failure_Invalid(t *testing.T) {
+	// Given
+	value := "not-a-cursor"
+
+	// When
+	cursor, err := domain.DecodeProductCursor(value)
+
+	// Then
+	assert.ErrorIs(t, err, domain.ErrInvalidProductCursor)
+	assert.Nil(t, cursor)
+}
@@ -3,6 +3,7 @@ package domain_test
 import (
 	"github.com/HongJungWan/commerce-system/test/fixtures"
 	"testing"
+	"time"
 
 	"github.com/HongJungWan/commerce-system/internal/domain"
 	"github.com/stretchr/testify/assert"
@@ -92,9 +93,57 @@ func TestProduct_CanBeDeleted_Failure_HasOrders(t *testing.T) {
 		ProductNumber: "P12345",
 	}
 	order := &domain.Order{
-		OrderNumber:   "O12345",
+		OrderNumber: "O12345",
+		Items:       []domain.OrderItem{{ProductNumber: "P12345"}},
+	}
+
+	db.Create(product)
+	db.Create(order)
+
+	// When
+	canBeDeleted, err := product.CanBeDeleted(db)
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, canBeDeleted)
+}
+
+func TestProduct_CanBeDeleted_Success_OrderIdMatchesProductId(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	product := &domain.Product{
+		ID:            1,
 		ProductNumber: "P12345",
 	}
+	order := &domain.Order{
+		ID:          1,
+		OrderNumber: "O12345",
+		Items:       []domain.OrderItem{{ProductNumber: "P99999"}},
+	}
+
+	db.Create(product)
+	db.Create(order)
+
+	// When
+	canBeDeleted, err := product.CanBeDeleted(db)
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, canBeDeleted)
+}
+
+func TestProduct_CanBeDeleted_Failure_OrderIdDiffersFromProductId(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	product := &domain.Product{
+		ID:            7,
+		ProductNumber: "P12345",
+	}
+	order := &domain.Order{
+		ID:          3,
+		OrderNumber: "O12345",
+		Items:       []domain.OrderItem{{ProductNumber: "P12345"}},
+	}
 
 	db.Create(product)
 	db.Create(order)
@@ -106,3 +155,82 @@ func TestProduct_CanBeDeleted_Failure_HasOrders(t *testing.T) {
 	assert.NoError(t, err)
 	assert.False(t, canBeDeleted)
 }
+
+func TestProduct_Archive_Success(t *testing.T) {
+	// Given
+	product := &domain.Product{
+		ProductNumber: "P12345",
+	}
+
+	// When
+	err := product.Archive(time.Now())
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, product.IsArchived())
+	assert.EqualError(t, product.CheckOrderable(), "판매가 중단된 상품입니다.")
+}
+
+func TestProduct_Archive_Failure_AlreadyArchived(t *testing.T) {
+	// Given
+	archivedAt := time.Now()
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ArchivedAt:    &archivedAt,
+	}
+
+	// When
+	err := product.Archive(time.Now())
+
+	// Then
+	assert.EqualError(t, err, "이미 보관 처리된 상품입니다.")
+}
+
+func TestProduct_Restore_Success(t *testing.T) {
+	// Given
+	archivedAt := time.Now()
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ArchivedAt:    &archivedAt,
+	}
+
+	// When
+	err := product.Restore()
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, product.IsArchived())
+	assert.NoError(t, product.CheckOrderable())
+}
+
+func TestProduct_ChangePrice_Success(t *testing.T) {
+	// Given
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		Price:         1000,
+	}
+
+	// When
+	change, err := product.ChangePrice(1200, "ADMIN1")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1200), product.Price)
+	assert.Equal(t, int64(1000), change.OldPrice)
+	assert.Equal(t, int64(1200), change.NewPrice)
+}
+
+func TestProduct_ChangePrice_Success_Unchanged(t *testing.T) {
+	// Given
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		Price:         1000,
+	}
+
+	// When
+	change, err := product.ChangePrice(1000, "ADMIN1")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Nil(t, change)
+}
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+// ProductOption 은 옵션 상품을 구분하는 옵션 정의입니다. (예: 사이즈 S, M, L)
+type ProductOption struct {
+	Name   string   `json:"name"`   // 옵션명
+	Values []string `json:"values"` // 선택 가능한 값
+}
+
+// HasVariants 는 옵션 정의가 있는 상위 상품인지 확인합니다. 상위 상품은 직접 주문하거나 재고를 가질 수 없습니다.
+func (p *Product) HasVariants() bool {
+	return len(p.Options) > 0
+}
+
+func (p *Product) IsVariant() bool {
+	return p.ParentProductNumber != ""
+}
+
+// CheckStockManaged 는 상품 자체에 재고를 둘 수 있는지 확인합니다.
+func (p *Product) CheckStockManaged() error {
+	if p.HasVariants() {
+		return errors.New("옵션이 있는 상품은 옵션 상품별로 재고를 관리합니다.")
+	}
+	return nil
+}
+
+// CheckCategoryAssignable 는 카테고리를 직접 지정할 수 있는 상품인지 확인합니다. 옵션 상품은 상위 상품의 카테고리를 따릅니다.
+func (p *Product) CheckCategoryAssignable() error {
+	if p.IsVariant() {
+		return errors.New("옵션 상품의 카테고리는 상위 상품에서 변경해 주세요.")
+	}
+	return nil
+}
+
+// NewVariant 는 상위 상품의 옵션 정의에 맞는 옵션 상품을 만듭니다.
+// 가격을 지정하지 않으면 상위 상품의 현재 가격을 따르며, 카테고리와 무게, 크기는 상위 상품을 따릅니다.
+func (p *Product) NewVariant(productNumber, sku string, optionValues map[string]string, price *int64, stockQuantity int) (*Product, error) {
+	if !p.HasVariants() {
+		return nil, errors.New("옵션이 정의되지 않은 상품입니다.")
+	}
+	if p.IsArchived() {
+		return nil, ErrProductArchived
+	}
+	if strings.TrimSpace(sku) == "" {
+		return nil, errors.New("SKU가 누락되었습니다.")
+	}
+	if len(optionValues) != len(p.Options) {
+		return nil, errors.New("모든 옵션의 값을 지정해야 합니다.")
+	}
+
+	labels := make([]string, 0, len(p.Options))
+	for _, option := range p.Options {
+		value, ok := optionValues[option.Name]
+		if !ok || !containsString(option.Values, value) {
+			return nil, errors.New("유효하지 않은 옵션 값입니다.")
+		}
+		labels = append(labels, value)
+	}
+
+	variant := &Product{
+		ProductNumber:       productNumber,
+		ProductName:         p.ProductName + " (" + strings.Join(labels, " / ") + ")",
+		CategoryID:          p.CategoryID,
+		Category:            p.Category,
+		Description:         p.Description,
+		Price:               p.Price,
+		StockQuantity:       stockQuantity,
+		Weight:              p.Weight,
+		Width:               p.Width,
+		Length:              p.Length,
+		Height:              p.Height,
+		ParentProductNumber: p.ProductNumber,
+		SKU:                 sku,
+		OptionValues:        optionValues,
+	}
+	if price != nil {
+		variant.Price = *price
+		variant.PriceOverride = price
+	}
+	if err := variant.Validate(); err != nil {
+		return nil, err
+	}
+	return variant, nil
+}
+
+// InheritsPrice 는 가격을 따로 정하지 않아 상위 상품 가격을 따르는 옵션 상품인지 확인합니다.
+func (p *Product) InheritsPrice() bool {
+	return p.IsVariant() && p.PriceOverride == nil
+}
+
+// ResolvePrice 는 상위 상품 가격을 따르는 옵션 상품의 가격을 상위 상품의 현재 가격으로 맞춥니다.
+func (p *Product) ResolvePrice(parent *Product) {
+	if p.InheritsPrice() && parent.ProductNumber == p.ParentProductNumber {
+		p.Price = parent.Price
+	}
+}
+
+// HasSameOptions 는 같은 옵션 조합의 옵션 상품인지 확인합니다.
+func (p *Product) HasSameOptions(optionValues map[string]string) bool {
+	if len(p.OptionValues) != len(optionValues) {
+		return false
+	}
+	for name, value := range optionValues {
+		if p.OptionValues[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Product) validateOptions() error {
+	if !p.HasVariants() {
+		return nil
+	}
+	if p.IsVariant() {
+		return errors.New("옵션 상품에는 옵션을 정의할 수 없습니다.")
+	}
+	if p.StockQuantity != 0 {
+		return p.CheckStockManaged()
+	}
+
+	names := make([]string, 0, len(p.Options))
+	for _, option := range p.Options {
+		if strings.TrimSpace(option.Name) == "" {
+			return errors.New("옵션명이 누락되었습니다.")
+		}
+		if containsString(names, option.Name) {
+			return errors.New("옵션명이 중복되었습니다.")
+		}
+		names = append(names, option.Name)
+
+		if len(option.Values) == 0 {
+			return errors.New("옵션 값이 누락되었습니다.")
+		}
+		for i, value := range option.Values {
+			if strings.TrimSpace(value) == "" || containsString(option.Values[:i], value) {
+				return errors.New("옵션 값이 잘못되었습니다.")
+			}
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,171 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func newParentProduct() *domain.Product {
+	return &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "T-Shirt",
+		Category:      "Clothing",
+		Price:         10000,
+		Weight:        200,
+		Options: []domain.ProductOption{
+			{Name: "size", Values: []string{"S", "M", "L"}},
+			{Name: "colour", Values: []string{"red", "blue"}},
+		},
+	}
+}
+
+func TestProduct_NewVariant_Success(t *testing.T) {
+	// Given
+	parent := newParentProduct()
+
+	// When
+	variant, err := parent.NewVariant("P12346", "TSHIRT-RED-M", map[string]string{"size": "M", "colour": "red"}, nil, 30)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "P12345", variant.ParentProductNumber)
+	assert.Equal(t, "TSHIRT-RED-M", variant.SKU)
+	assert.Equal(t, "T-Shirt (M / red)", variant.ProductName)
+	assert.Equal(t, "Clothing", variant.Category)
+	assert.Equal(t, int64(10000), variant.Price)
+	assert.Equal(t, 200, variant.Weight)
+	assert.Equal(t, 30, variant.StockQuantity)
+	assert.True(t, variant.IsVariant())
+	assert.NoError(t, variant.CheckOrderable())
+}
+
+func TestProduct_NewVariant_Success_PriceOverride(t *testing.T) {
+	// Given
+	parent := newParentProduct()
+	price := int64(12000)
+
+	// When
+	variant, err := parent.NewVariant("P12346", "TSHIRT-BLUE-L", map[string]string{"size": "L", "colour": "blue"}, &price, 0)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12000), variant.Price)
+	assert.False(t, variant.InheritsPrice())
+}
+
+func TestProduct_ResolvePrice_FollowsParentPrice(t *testing.T) {
+	// Given
+	parent := newParentProduct()
+	variant, _ := parent.NewVariant("P12346", "TSHIRT-RED-M", map[string]string{"size": "M", "colour": "red"}, nil, 30)
+	parent.Price = 15000
+
+	// When
+	variant.ResolvePrice(parent)
+
+	// Then
+	assert.True(t, variant.InheritsPrice())
+	assert.Equal(t, int64(15000), variant.Price)
+}
+
+func TestProduct_ResolvePrice_KeepsPriceOverride(t *testing.T) {
+	// Given
+	parent := newParentProduct()
+	variant, _ := parent.NewVariant("P12346", "TSHIRT-RED-M", map[string]string{"size": "M", "colour": "red"}, nil, 30)
+	_, _ = variant.ChangePrice(12000, "ADMIN1")
+	parent.Price = 15000
+
+	// When
+	variant.ResolvePrice(parent)
+
+	// Then
+	assert.False(t, variant.InheritsPrice())
+	assert.Equal(t, int64(12000), variant.Price)
+}
+
+func TestProduct_NewVariant_Failure_InvalidOptionValue(t *testing.T) {
+	// Given
+	parent := newParentProduct()
+
+	// When
+	variant, err := parent.NewVariant("P12346", "TSHIRT-GREEN-M", map[string]string{"size": "M", "colour": "green"}, nil, 10)
+
+	// Then
+	assert.EqualError(t, err, "유효하지 않은 옵션 값입니다.")
+	assert.Nil(t, variant)
+}
+
+func TestProduct_NewVariant_Failure_MissingOption(t *testing.T) {
+	// Given
+	parent := newParentProduct()
+
+	// When
+	variant, err := parent.NewVariant("P12346", "TSHIRT-M", map[string]string{"size": "M"}, nil, 10)
+
+	// Then
+	assert.EqualError(t, err, "모든 옵션의 값을 지정해야 합니다.")
+	assert.Nil(t, variant)
+}
+
+func TestProduct_NewVariant_Failure_NoOptions(t *testing.T) {
+	// Given
+	parent := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Mug",
+		Price:         5000,
+	}
+
+	// When
+	variant, err := parent.NewVariant("P12346", "MUG-1", map[string]string{}, nil, 10)
+
+	// Then
+	assert.EqualError(t, err, "옵션이 정의되지 않은 상품입니다.")
+	assert.Nil(t, variant)
+}
+
+func TestProduct_Validate_Failure_ParentWithStock(t *testing.T) {
+	// Given
+	parent := newParentProduct()
+	parent.StockQuantity = 10
+
+	// When
+	err := parent.Validate()
+
+	// Then
+	assert.EqualError(t, err, "옵션이 있는 상품은 옵션 상품별로 재고를 관리합니다.")
+}
+
+func TestProduct_Validate_Failure_DuplicateOptionName(t *testing.T) {
+	// Given
+	parent := newParentProduct()
+	parent.Options = append(parent.Options, domain.ProductOption{Name: "size", Values: []string{"XL"}})
+
+	// When
+	err := parent.Validate()
+
+	// Then
+	assert.EqualError(t, err, "옵션명이 중복되었습니다.")
+}
+
+func TestProduct_CheckOrderable_Failure_Parent(t *testing.T) {
+	// Given
+	parent := newParentProduct()
+
+	// When
+	err := parent.CheckOrderable()
+
+	// Then
+	assert.EqualError(t, err, "옵션 상품을 선택해 주세요.")
+}
+
+func TestProduct_HasSameOptions(t *testing.T) {
+	// Given
+	variant := &domain.Product{
+		OptionValues: map[string]string{"size": "M", "colour": "red"},
+	}
+
+	// When & Then
+	assert.True(t, variant.HasSameOptions(map[string]string{"colour": "red", "size": "M"}))
+	assert.False(t, variant.HasSameOptions(map[string]string{"colour": "blue", "size": "M"}))
+}
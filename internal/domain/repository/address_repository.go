@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"gorm.io/gorm"
+)
+
+type AddressRepository interface {
+	WithTx(tx *gorm.DB) AddressRepository
+	Create(address *domain.Address) error
+	GetById(id int) (*domain.Address, error)
+	GetByMemberNumber(memberNumber string) ([]*domain.Address, error)
+	GetDefault(memberNumber string) (*domain.Address, error)
+	CountByMemberNumber(memberNumber string) (int64, error)
+	ClearDefault(memberNumber string) error
+	Update(address *domain.Address) error
+	Delete(id int) error
+}
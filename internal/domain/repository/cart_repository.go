@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"gorm.io/gorm"
+)
+
+type CartRepository interface {
+	WithTx(tx *gorm.DB) CartRepository
+	GetByMemberNumber(memberNumber string) (*domain.Cart, error)
+	Save(cart *domain.Cart) error
+}
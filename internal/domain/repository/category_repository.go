@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"gorm.io/gorm"
+)
+
+type CategoryRepository interface {
+	WithTx(tx *gorm.DB) CategoryRepository
+	Create(category *domain.Category) error
+	GetAll() ([]*domain.Category, error)
+	GetById(id int) (*domain.Category, error)
+	GetBySlug(slug string) (*domain.Category, error)
+	Update(category *domain.Category) error
+	Delete(id int) error
+	CountProducts(id int) (int64, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"gorm.io/gorm"
+)
+
+type CouponRepository interface {
+	WithTx(tx *gorm.DB) CouponRepository
+	Create(coupon *domain.Coupon) error
+	GetAll() ([]*domain.Coupon, error)
+	GetById(id int) (*domain.Coupon, error)
+	GetByCode(code string) (*domain.Coupon, error)
+	GetByIdForUpdate(id int) (*domain.Coupon, error)
+	Update(coupon *domain.Coupon) error
+	Delete(id int) error
+	CountUsageByMember(couponID int, memberNumber string) (int64, error)
+	Use(coupon *domain.Coupon, usage *domain.CouponUsage) error
+	ReleaseUsage(orderID int) error
+}
@@ -0,0 +1,10 @@
+package repository
+
+import "github.com/HongJungWan/commerce-system/internal/domain"
+
+type IdempotencyKeyRepository interface {
+	Create(key *domain.IdempotencyKey) error
+	GetByKey(memberNumber string, key string) (*domain.IdempotencyKey, error)
+	Update(key *domain.IdempotencyKey) error
+	Delete(id int) error
+}
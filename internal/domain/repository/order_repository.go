@@ -2,13 +2,20 @@ package repository
 
 import (
 	"github.com/HongJungWan/commerce-system/internal/domain"
+	"gorm.io/gorm"
 )
 
 type OrderRepository interface {
+	WithTx(tx *gorm.DB) OrderRepository
 	Create(order *domain.Order) error
 	GetByOrderNumber(orderNumber string) (*domain.Order, error)
 	GetById(id int) (*domain.Order, error)
+	GetByIdForUpdate(id int) (*domain.Order, error)
 	GetByMemberNumber(memberNumber string) ([]*domain.Order, error)
+	GetByStatus(statuses ...domain.OrderStatus) ([]*domain.Order, error)
+	Search(query *domain.OrderQuery) ([]*domain.Order, bool, error)
+	SearchByCriteria(criteria *domain.OrderSearchCriteria) ([]*domain.Order, int64, error)
 	Update(order *domain.Order) error
-	GetMonthlyStats(month string) (int64, int64, error)
+	CompletePaymentRefund(paymentID int, amount int64) error
+	GetMonthlyStats(month string) (*domain.OrderStats, error)
 }
@@ -1,12 +1,24 @@
 package repository
 
-import "github.com/HongJungWan/commerce-system/internal/domain"
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"gorm.io/gorm"
+)
 
 type ProductRepository interface {
+	WithTx(tx *gorm.DB) ProductRepository
 	Create(product *domain.Product) error
-	GetAll(filter map[string]interface{}) ([]*domain.Product, error)
+	Search(query *domain.ProductQuery) ([]*domain.Product, *domain.ProductCursor, error)
+	Count(query *domain.ProductQuery) (int64, error)
 	GetById(id int) (*domain.Product, error)
 	GetByProductNumber(productNumber string) (*domain.Product, error)
+	GetBySKU(sku string) (*domain.Product, error)
+	GetVariants(parentProductNumbers ...string) ([]*domain.Product, error)
 	Update(product *domain.Product) error
+	ApplyStockMovement(movement *domain.StockMovement) error
+	ApplyStockMovementIfCurrent(movement *domain.StockMovement, expectedQuantity int) error
+	GetStockMovements(productNumber string) ([]*domain.StockMovement, error)
+	CreatePriceChange(change *domain.ProductPriceChange) error
+	GetPriceChanges(productNumber string) ([]*domain.ProductPriceChange, error)
 	Delete(id int) error
 }
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"gorm.io/gorm"
+)
+
+type ReturnRequestRepository interface {
+	WithTx(tx *gorm.DB) ReturnRequestRepository
+	Create(returnRequest *domain.ReturnRequest) error
+	GetById(id int) (*domain.ReturnRequest, error)
+	GetByIdForUpdate(id int) (*domain.ReturnRequest, error)
+	GetByMemberNumber(memberNumber string) ([]*domain.ReturnRequest, error)
+	GetByStatus(status domain.ReturnStatus) ([]*domain.ReturnRequest, error)
+	Update(returnRequest *domain.ReturnRequest) error
+}
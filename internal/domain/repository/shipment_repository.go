@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"gorm.io/gorm"
+)
+
+type ShipmentRepository interface {
+	WithTx(tx *gorm.DB) ShipmentRepository
+	Create(shipment *domain.Shipment) error
+	GetById(id int) (*domain.Shipment, error)
+	GetByIdForUpdate(id int) (*domain.Shipment, error)
+	GetByOrderID(orderID int) ([]*domain.Shipment, error)
+	Update(shipment *domain.Shipment) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"gorm.io/gorm"
+)
+
+type TaxClassRepository interface {
+	WithTx(tx *gorm.DB) TaxClassRepository
+	Create(taxClass *domain.TaxClass) error
+	GetAll() ([]*domain.TaxClass, error)
+	GetById(id int) (*domain.TaxClass, error)
+	GetByName(name string) (*domain.TaxClass, error)
+	Update(taxClass *domain.TaxClass) error
+	Delete(id int) error
+}
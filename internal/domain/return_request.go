@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type ReturnStatus string
+
+const (
+	ReturnStatusRequested ReturnStatus = "requested" // 반품 요청
+	ReturnStatusApproved  ReturnStatus = "approved"  // 반품 승인
+	ReturnStatusReceived  ReturnStatus = "received"  // 반품 입고
+	ReturnStatusRefunded  ReturnStatus = "refunded"  // 환불 완료
+	ReturnStatusRejected  ReturnStatus = "rejected"  // 반품 거절
+)
+
+type ReturnRequest struct {
+	ID            int          `gorm:"primaryKey;autoIncrement" json:"id"`                              // 기본 키
+	OrderID       int          `gorm:"index;not null" json:"order_id"`                                  // 주문 기본 키
+	MemberNumber  string       `gorm:"index;not null" json:"member_number"`                             // 회원번호
+	ProductNumber string       `gorm:"not null" json:"product_number"`                                  // 상품번호
+	Quantity      int          `gorm:"not null" json:"quantity"`                                        // 반품 수량
+	Amount        int64        `gorm:"not null" json:"amount"`                                          // 환불 예정 금액
+	Reason        string       `gorm:"not null" json:"reason"`                                          // 반품 사유
+	Status        ReturnStatus `gorm:"type:varchar(20);not null;default:requested;index" json:"status"` // 반품상태
+	RequestedAt   time.Time    `gorm:"not null" json:"requested_at"`                                    // 요청일
+	ApprovedAt    *time.Time   `json:"approved_at,omitempty"`                                           // 승인일
+	ReceivedAt    *time.Time   `json:"received_at,omitempty"`                                           // 입고일
+	RefundedAt    *time.Time   `json:"refunded_at,omitempty"`                                           // 환불일
+	RejectedAt    *time.Time   `json:"rejected_at,omitempty"`                                           // 거절일
+}
+
+func (r *ReturnRequest) Validate() error {
+	if r.OrderID == 0 {
+		return errors.New("주문 정보가 누락되었습니다.")
+	}
+	if r.ProductNumber == "" {
+		return errors.New("상품번호가 누락되었습니다.")
+	}
+	if r.Quantity <= 0 {
+		return errors.New("반품 수량이 잘못되었습니다.")
+	}
+	if r.Reason == "" {
+		return errors.New("반품 사유가 누락되었습니다.")
+	}
+	return nil
+}
+
+func ParseReturnStatus(status string) (ReturnStatus, error) {
+	switch ReturnStatus(status) {
+	case ReturnStatusRequested, ReturnStatusApproved, ReturnStatusReceived, ReturnStatusRefunded, ReturnStatusRejected:
+		return ReturnStatus(status), nil
+	}
+	return "", errors.New("유효하지 않은 반품 상태입니다.")
+}
+
+func (r *ReturnRequest) Approve() error {
+	if r.Status != ReturnStatusRequested {
+		return errors.New("요청된 반품만 승인할 수 있습니다.")
+	}
+	now := time.Now()
+	r.Status = ReturnStatusApproved
+	r.ApprovedAt = &now
+	return nil
+}
+
+func (r *ReturnRequest) Reject() error {
+	if r.Status != ReturnStatusRequested && r.Status != ReturnStatusApproved {
+		return errors.New("입고 전인 반품만 거절할 수 있습니다.")
+	}
+	now := time.Now()
+	r.Status = ReturnStatusRejected
+	r.RejectedAt = &now
+	return nil
+}
+
+func (r *ReturnRequest) Receive() error {
+	if r.Status != ReturnStatusApproved {
+		return errors.New("승인된 반품만 입고 처리할 수 있습니다.")
+	}
+	now := time.Now()
+	r.Status = ReturnStatusReceived
+	r.ReceivedAt = &now
+	return nil
+}
+
+func (r *ReturnRequest) Refund() error {
+	if r.Status != ReturnStatusReceived {
+		return errors.New("입고된 반품만 환불할 수 있습니다.")
+	}
+	now := time.Now()
+	r.Status = ReturnStatusRefunded
+	r.RefundedAt = &now
+	return nil
+}
+
+func (r *ReturnRequest) ChangeStatus(status ReturnStatus) error {
+	switch status {
+	case ReturnStatusApproved:
+		return r.Approve()
+	case ReturnStatusRejected:
+		return r.Reject()
+	case ReturnStatusReceived:
+		return r.Receive()
+	case ReturnStatusRefunded:
+		return r.Refund()
+	}
+	return errors.New("변경할 수 없는 반품 상태입니다.")
+}
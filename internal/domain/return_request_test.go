@@ -0,0 +1,78 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReturnRequest_Validate_Failure_MissingReason(t *testing.T) {
+	// Given
+	returnRequest := &domain.ReturnRequest{
+		OrderID:       12345,
+		ProductNumber: "P12345",
+		Quantity:      1,
+	}
+
+	// When
+	err := returnRequest.Validate()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "반품 사유가 누락되었습니다.", err.Error())
+}
+
+func TestReturnRequest_Transitions_Success(t *testing.T) {
+	// Given
+	returnRequest := &domain.ReturnRequest{
+		OrderID:       12345,
+		ProductNumber: "P12345",
+		Quantity:      1,
+		Reason:        "파손",
+		Status:        domain.ReturnStatusRequested,
+		RequestedAt:   time.Now(),
+	}
+
+	// When & Then
+	assert.NoError(t, returnRequest.ChangeStatus(domain.ReturnStatusApproved))
+	assert.NotNil(t, returnRequest.ApprovedAt)
+	assert.NoError(t, returnRequest.ChangeStatus(domain.ReturnStatusReceived))
+	assert.NotNil(t, returnRequest.ReceivedAt)
+	assert.NoError(t, returnRequest.ChangeStatus(domain.ReturnStatusRefunded))
+	assert.NotNil(t, returnRequest.RefundedAt)
+	assert.Equal(t, domain.ReturnStatusRefunded, returnRequest.Status)
+}
+
+func TestReturnRequest_Reject_Failure_AlreadyReceived(t *testing.T) {
+	// Given
+	returnRequest := &domain.ReturnRequest{Status: domain.ReturnStatusReceived}
+
+	// When
+	err := returnRequest.Reject()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "입고 전인 반품만 거절할 수 있습니다.", err.Error())
+}
+
+func TestReturnRequest_Receive_Failure_NotApproved(t *testing.T) {
+	// Given
+	returnRequest := &domain.ReturnRequest{Status: domain.ReturnStatusRequested}
+
+	// When
+	err := returnRequest.Receive()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "승인된 반품만 입고 처리할 수 있습니다.", err.Error())
+}
+
+func TestParseReturnStatus_Failure_Unknown(t *testing.T) {
+	// When
+	_, err := domain.ParseReturnStatus("unknown")
+
+	// Then
+	assert.Error(t, err)
+}
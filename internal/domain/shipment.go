@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type ShipmentStatus string
+
+const (
+	ShipmentStatusInTransit ShipmentStatus = "in_transit" // 배송 중
+	ShipmentStatusDelivered ShipmentStatus = "delivered"  // 배송 완료
+)
+
+type Shipment struct {
+	ID             int            `gorm:"primaryKey;autoIncrement" json:"id"`                               // 기본 키
+	OrderID        int            `gorm:"index;not null" json:"order_id"`                                   // 주문 기본 키
+	Carrier        string         `gorm:"not null" json:"carrier"`                                          // 택배사
+	TrackingNumber string         `gorm:"unique;not null" json:"tracking_number"`                           // 운송장 번호
+	Status         ShipmentStatus `gorm:"type:varchar(20);not null;default:in_transit;index" json:"status"` // 배송상태
+	ShippedAt      time.Time      `gorm:"not null" json:"shipped_at"`                                       // 발송일
+	DeliveredAt    *time.Time     `json:"delivered_at,omitempty"`                                           // 배송 완료일
+}
+
+func NewShipment(orderID int, carrier, trackingNumber string) (*Shipment, error) {
+	shipment := &Shipment{
+		OrderID:        orderID,
+		Carrier:        carrier,
+		TrackingNumber: trackingNumber,
+		Status:         ShipmentStatusInTransit,
+		ShippedAt:      time.Now(),
+	}
+	if err := shipment.Validate(); err != nil {
+		return nil, err
+	}
+	return shipment, nil
+}
+
+func (s *Shipment) Validate() error {
+	if s.OrderID == 0 {
+		return errors.New("주문 정보가 누락되었습니다.")
+	}
+	if s.Carrier == "" {
+		return errors.New("택배사가 누락되었습니다.")
+	}
+	if s.TrackingNumber == "" {
+		return errors.New("운송장 번호가 누락되었습니다.")
+	}
+	return nil
+}
+
+func (s *Shipment) IsDelivered() bool {
+	return s.Status == ShipmentStatusDelivered
+}
+
+func (s *Shipment) Deliver(deliveredAt time.Time) error {
+	if s.Status != ShipmentStatusInTransit {
+		return errors.New("배송 중인 배송만 배송 완료 처리할 수 있습니다.")
+	}
+	s.Status = ShipmentStatusDelivered
+	s.DeliveredAt = &deliveredAt
+	return nil
+}
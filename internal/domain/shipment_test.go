@@ -0,0 +1,48 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewShipment_Failure_MissingTrackingNumber(t *testing.T) {
+	// Given
+	orderID := 12345
+
+	// When
+	shipment, err := domain.NewShipment(orderID, "fake", "")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, shipment)
+	assert.Equal(t, "운송장 번호가 누락되었습니다.", err.Error())
+}
+
+func TestShipment_Deliver_Success(t *testing.T) {
+	// Given
+	shipment, _ := domain.NewShipment(12345, "fake", "FAKE-O12345-1")
+
+	// When
+	err := shipment.Deliver(time.Now())
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, shipment.IsDelivered())
+	assert.NotNil(t, shipment.DeliveredAt)
+}
+
+func TestShipment_Deliver_Failure_AlreadyDelivered(t *testing.T) {
+	// Given
+	shipment, _ := domain.NewShipment(12345, "fake", "FAKE-O12345-1")
+	_ = shipment.Deliver(time.Now())
+
+	// When
+	err := shipment.Deliver(time.Now())
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "배송 중인 배송만 배송 완료 처리할 수 있습니다.", err.Error())
+}
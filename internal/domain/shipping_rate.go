@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+type ShippingRateTable struct {
+	FreeShippingThreshold int64          // 이 금액 이상 주문 시 무료 배송 (0이면 적용 안 함)
+	VolumetricDivisor     int64          // 부피 무게 계산 기준 (cm³ / kg, 0이면 부피 무게 미적용)
+	DefaultZone           string         // 우편번호가 일치하는 권역이 없을 때 적용할 권역
+	Zones                 []ShippingZone // 배송 권역
+}
+
+type ShippingZone struct {
+	Name        string               // 권역명
+	ZipPrefixes []string             // 권역에 해당하는 우편번호 앞자리
+	Rates       []ShippingWeightRate // 무게 구간별 배송비
+}
+
+type ShippingWeightRate struct {
+	MaxWeight int   // 구간 최대 무게 (g)
+	Fee       int64 // 배송비
+}
+
+func (t *ShippingRateTable) Validate() error {
+	if t.FreeShippingThreshold < 0 || t.VolumetricDivisor < 0 {
+		return errors.New("배송비 설정이 잘못되었습니다.")
+	}
+	if t.findZone(t.DefaultZone) == nil {
+		return errors.New("기본 배송 권역이 존재하지 않습니다.")
+	}
+	for _, zone := range t.Zones {
+		if len(zone.Rates) == 0 {
+			return errors.New("배송비 구간이 누락된 권역이 있습니다.")
+		}
+		for _, rate := range zone.Rates {
+			if rate.MaxWeight <= 0 || rate.Fee < 0 {
+				return errors.New("배송비 구간이 잘못되었습니다.")
+			}
+		}
+	}
+	return nil
+}
+
+// 실제 무게와 부피 무게 중 큰 값을 상품의 배송 무게로 사용
+func (t *ShippingRateTable) ChargeableWeight(product *Product) int {
+	weight := product.Weight
+	if t.VolumetricDivisor > 0 {
+		volumetric := int(int64(product.Width*product.Length*product.Height) * 1000 / t.VolumetricDivisor)
+		if volumetric > weight {
+			weight = volumetric
+		}
+	}
+	return weight
+}
+
+func (t *ShippingRateTable) CalculateFee(zipCode string, weight int, merchandiseAmount int64) (int64, error) {
+	if t.FreeShippingThreshold > 0 && merchandiseAmount >= t.FreeShippingThreshold {
+		return 0, nil
+	}
+
+	zone := t.zoneFor(zipCode)
+	if zone == nil {
+		return 0, errors.New("배송 가능한 권역이 아닙니다.")
+	}
+
+	rates := append([]ShippingWeightRate(nil), zone.Rates...)
+	sort.Slice(rates, func(i, j int) bool { return rates[i].MaxWeight < rates[j].MaxWeight })
+	for _, rate := range rates {
+		if weight <= rate.MaxWeight {
+			return rate.Fee, nil
+		}
+	}
+	return 0, errors.New("배송 가능한 무게를 초과했습니다.")
+}
+
+func (t *ShippingRateTable) zoneFor(zipCode string) *ShippingZone {
+	if zipCode != "" {
+		for i := range t.Zones {
+			for _, prefix := range t.Zones[i].ZipPrefixes {
+				if strings.HasPrefix(zipCode, prefix) {
+					return &t.Zones[i]
+				}
+			}
+		}
+	}
+	return t.findZone(t.DefaultZone)
+}
+
+func (t *ShippingRateTable) findZone(name string) *ShippingZone {
+	for i := range t.Zones {
+		if t.Zones[i].Name == name {
+			return &t.Zones[i]
+		}
+	}
+	return nil
+}
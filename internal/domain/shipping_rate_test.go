@@ -0,0 +1,103 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestShippingRateTable() *domain.ShippingRateTable {
+	return &domain.ShippingRateTable{
+		FreeShippingThreshold: 50000,
+		VolumetricDivisor:     6000,
+		DefaultZone:           "mainland",
+		Zones: []domain.ShippingZone{
+			{
+				Name:  "mainland",
+				Rates: []domain.ShippingWeightRate{{MaxWeight: 5000, Fee: 4000}, {MaxWeight: 2000, Fee: 3000}},
+			},
+			{
+				Name:        "jeju",
+				ZipPrefixes: []string{"63"},
+				Rates:       []domain.ShippingWeightRate{{MaxWeight: 2000, Fee: 6000}},
+			},
+		},
+	}
+}
+
+func TestShippingRateTable_Validate_Failure_MissingDefaultZone(t *testing.T) {
+	// Given
+	table := newTestShippingRateTable()
+	table.DefaultZone = "island"
+
+	// When
+	err := table.Validate()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "기본 배송 권역이 존재하지 않습니다.", err.Error())
+}
+
+func TestShippingRateTable_CalculateFee_WeightBracket(t *testing.T) {
+	// Given
+	table := newTestShippingRateTable()
+
+	// When
+	light, lightErr := table.CalculateFee("06236", 1500, 10000)
+	heavy, heavyErr := table.CalculateFee("06236", 3000, 10000)
+
+	// Then
+	assert.NoError(t, lightErr)
+	assert.EqualValues(t, 3000, light)
+	assert.NoError(t, heavyErr)
+	assert.EqualValues(t, 4000, heavy)
+}
+
+func TestShippingRateTable_CalculateFee_ZoneByZipCode(t *testing.T) {
+	// Given
+	table := newTestShippingRateTable()
+
+	// When
+	fee, err := table.CalculateFee("63100", 1000, 10000)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 6000, fee)
+}
+
+func TestShippingRateTable_CalculateFee_FreeShipping(t *testing.T) {
+	// Given
+	table := newTestShippingRateTable()
+
+	// When
+	fee, err := table.CalculateFee("63100", 1000, 50000)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, fee)
+}
+
+func TestShippingRateTable_CalculateFee_Failure_Overweight(t *testing.T) {
+	// Given
+	table := newTestShippingRateTable()
+
+	// When
+	_, err := table.CalculateFee("06236", 6000, 10000)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "배송 가능한 무게를 초과했습니다.", err.Error())
+}
+
+func TestShippingRateTable_ChargeableWeight_Volumetric(t *testing.T) {
+	// Given
+	table := newTestShippingRateTable()
+	product := &domain.Product{Weight: 500, Width: 30, Length: 40, Height: 20}
+
+	// When
+	weight := table.ChargeableWeight(product)
+
+	// Then
+	assert.Equal(t, 4000, weight)
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type StockMovementType string
+
+const (
+	StockMovementOrder      StockMovementType = "order"      // 주문 차감
+	StockMovementCancel     StockMovementType = "cancel"     // 주문 취소 복원
+	StockMovementAdjustment StockMovementType = "adjustment" // 수동 조정
+	StockMovementReturn     StockMovementType = "return"     // 반품 입고
+	StockMovementReceipt    StockMovementType = "receipt"    // 상품 입고
+)
+
+// StockMovement 는 재고 변동 이력이며, 한 번 기록되면 수정하지 않습니다.
+type StockMovement struct {
+	ID            int               `gorm:"primaryKey;autoIncrement" json:"id"`        // 기본 키
+	ProductNumber string            `gorm:"index;not null" json:"product_number"`      // 상품번호
+	Type          StockMovementType `gorm:"type:varchar(20);not null" json:"type"`     // 변동 유형
+	Delta         int               `gorm:"not null" json:"delta"`                     // 변동 수량 (차감은 음수)
+	Balance       int               `gorm:"not null" json:"balance"`                   // 변동 후 재고수량
+	Actor         string            `json:"actor"`                                     // 처리자 회원번호
+	Reference     string            `gorm:"index" json:"reference"`                    // 참조 번호 (주문번호, 반품 ID 등)
+	Reason        string            `json:"reason"`                                    // 수동 조정 사유
+	CreatedAt     time.Time         `gorm:"not null;autoCreateTime" json:"created_at"` // 기록일
+}
+
+func NewStockMovement(productNumber string, movementType StockMovementType, delta int, actor, reference string) (*StockMovement, error) {
+	movement := &StockMovement{
+		ProductNumber: productNumber,
+		Type:          movementType,
+		Delta:         delta,
+		Actor:         actor,
+		Reference:     reference,
+	}
+	if err := movement.Validate(); err != nil {
+		return nil, err
+	}
+	return movement, nil
+}
+
+func (m *StockMovement) Validate() error {
+	if m.ProductNumber == "" {
+		return errors.New("상품번호가 누락되었습니다.")
+	}
+	switch m.Type {
+	case StockMovementOrder, StockMovementCancel, StockMovementAdjustment, StockMovementReturn, StockMovementReceipt:
+	default:
+		return errors.New("유효하지 않은 재고 변동 유형입니다.")
+	}
+	if m.Delta == 0 {
+		return errors.New("재고 변동 수량이 누락되었습니다.")
+	}
+	return nil
+}
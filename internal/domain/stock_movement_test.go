@@ -0,0 +1,39 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStockMovement_Success(t *testing.T) {
+	// When
+	movement, err := domain.NewStockMovement("P12345", domain.StockMovementOrder, -2, "M12345", "O12345")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "P12345", movement.ProductNumber)
+	assert.Equal(t, -2, movement.Delta)
+	assert.Equal(t, "O12345", movement.Reference)
+}
+
+func TestNewStockMovement_Failure_InvalidType(t *testing.T) {
+	// When
+	movement, err := domain.NewStockMovement("P12345", "transfer", 2, "M12345", "")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, movement)
+	assert.Equal(t, "유효하지 않은 재고 변동 유형입니다.", err.Error())
+}
+
+func TestNewStockMovement_Failure_ZeroDelta(t *testing.T) {
+	// When
+	movement, err := domain.NewStockMovement("P12345", domain.StockMovementAdjustment, 0, "ADMIN1", "")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, movement)
+	assert.Equal(t, "재고 변동 수량이 누락되었습니다.", err.Error())
+}
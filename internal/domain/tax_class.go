@@ -0,0 +1,61 @@
+package domain
+
+import "errors"
+
+type TaxPriceMode string
+
+const (
+	TaxPriceModeExclusive TaxPriceMode = "exclusive" // 세금 별도 가격
+	TaxPriceModeInclusive TaxPriceMode = "inclusive" // 세금 포함 가격
+)
+
+// 세율 계산 기준 (만분율)
+const taxRateBase = 10000
+
+type TaxClass struct {
+	ID         int          `gorm:"primaryKey;autoIncrement" json:"id"`          // 기본 키
+	Name       string       `gorm:"unique;not null" json:"name"`                 // 과세 분류명
+	Rate       int64        `gorm:"not null" json:"rate"`                        // 세율 (만분율, 1000이면 10%)
+	PriceMode  TaxPriceMode `gorm:"type:varchar(20);not null" json:"price_mode"` // 가격 표시 방식
+	IsDefault  bool         `gorm:"not null;default:false" json:"is_default"`    // 지정된 카테고리가 없는 상품에 적용
+	Categories []string     `gorm:"type:text;serializer:json" json:"categories"` // 적용 카테고리 슬러그
+}
+
+func (tc *TaxClass) Validate() error {
+	if tc.Name == "" {
+		return errors.New("과세 분류명이 누락되었습니다.")
+	}
+	if tc.Rate < 0 || tc.Rate > taxRateBase {
+		return errors.New("세율이 잘못되었습니다.")
+	}
+	switch tc.PriceMode {
+	case TaxPriceModeExclusive, TaxPriceModeInclusive:
+	default:
+		return errors.New("유효하지 않은 가격 표시 방식입니다.")
+	}
+	return nil
+}
+
+func (tc *TaxClass) AppliesTo(category string) bool {
+	for _, c := range tc.Categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+func (tc *TaxClass) IsInclusive() bool {
+	return tc.PriceMode == TaxPriceModeInclusive
+}
+
+// 세금 포함 가격이면 금액에 포함된 세금을, 별도 가격이면 추가될 세금을 계산
+func (tc *TaxClass) CalculateTax(amount int64) int64 {
+	if amount <= 0 {
+		return 0
+	}
+	if tc.IsInclusive() {
+		return amount * tc.Rate / (taxRateBase + tc.Rate)
+	}
+	return amount * tc.Rate / taxRateBase
+}
@@ -0,0 +1,53 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxClass_Validate_Success(t *testing.T) {
+	// Given
+	taxClass := &domain.TaxClass{Name: "표준세율", Rate: 1000, PriceMode: domain.TaxPriceModeExclusive}
+
+	// When
+	err := taxClass.Validate()
+
+	// Then
+	assert.NoError(t, err)
+}
+
+func TestTaxClass_Validate_Failure_InvalidPriceMode(t *testing.T) {
+	// Given
+	taxClass := &domain.TaxClass{Name: "표준세율", Rate: 1000, PriceMode: "gross"}
+
+	// When
+	err := taxClass.Validate()
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "유효하지 않은 가격 표시 방식입니다.", err.Error())
+}
+
+func TestTaxClass_CalculateTax_Exclusive(t *testing.T) {
+	// Given
+	taxClass := &domain.TaxClass{Name: "표준세율", Rate: 1000, PriceMode: domain.TaxPriceModeExclusive}
+
+	// When
+	tax := taxClass.CalculateTax(10000)
+
+	// Then
+	assert.EqualValues(t, 1000, tax)
+}
+
+func TestTaxClass_CalculateTax_Inclusive(t *testing.T) {
+	// Given
+	taxClass := &domain.TaxClass{Name: "식품 경감세율", Rate: 800, PriceMode: domain.TaxPriceModeInclusive}
+
+	// When
+	tax := taxClass.CalculateTax(10800)
+
+	// Then
+	assert.EqualValues(t, 800, tax)
+}
@@ -0,0 +1,20 @@
+package configs
+
+import "github.com/HongJungWan/commerce-system/internal/domain"
+
+type CancellationConfig struct {
+	MaxHoursAfterOrder int            `mapstructure:"max_hours_after_order"`
+	CategoryMaxHours   map[string]int `mapstructure:"category_max_hours"`
+}
+
+// 취소 가능 시간이 설정되지 않았으면 nil을 반환하며, 이 경우 배송 전까지 언제든 취소할 수 있음
+func (c CancellationConfig) Policy() *domain.CancellationPolicy {
+	if c.MaxHoursAfterOrder == 0 && len(c.CategoryMaxHours) == 0 {
+		return nil
+	}
+
+	return &domain.CancellationPolicy{
+		MaxHoursAfterOrder: c.MaxHoursAfterOrder,
+		CategoryMaxHours:   c.CategoryMaxHours,
+	}
+}
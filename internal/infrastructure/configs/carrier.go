@@ -0,0 +1,5 @@
+package configs
+
+type CarrierConfig struct {
+	Provider string `mapstructure:"provider"` // 택배사 (fake: 외부 호출 없는 로컬용, 미설정 시 fake)
+}
@@ -14,4 +14,9 @@ type Config struct {
 	Version  string   `toml:"VERSION"`
 	BasePath string   `toml:"BASEPATH"`
 	Title    string   `toml:"TITLE"`
+
+	Payment      PaymentConfig      `mapstructure:"payment"`
+	Carrier      CarrierConfig      `mapstructure:"carrier"`
+	Shipping     ShippingConfig     `mapstructure:"shipping"`
+	Cancellation CancellationConfig `mapstructure:"cancellation"`
 }
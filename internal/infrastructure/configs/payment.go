@@ -0,0 +1,5 @@
+package configs
+
+type PaymentConfig struct {
+	Provider string `mapstructure:"provider"` // 결제 대행사 (fake: 외부 호출 없는 로컬용, 미설정 시 fake)
+}
@@ -0,0 +1,42 @@
+package configs
+
+import "github.com/HongJungWan/commerce-system/internal/domain"
+
+type ShippingConfig struct {
+	FreeShippingThreshold int64                `mapstructure:"free_shipping_threshold"`
+	VolumetricDivisor     int64                `mapstructure:"volumetric_divisor"`
+	DefaultZone           string               `mapstructure:"default_zone"`
+	Zones                 []ShippingZoneConfig `mapstructure:"zones"`
+}
+
+type ShippingZoneConfig struct {
+	Name        string               `mapstructure:"name"`
+	ZipPrefixes []string             `mapstructure:"zip_prefixes"`
+	Rates       []ShippingRateConfig `mapstructure:"rates"`
+}
+
+type ShippingRateConfig struct {
+	MaxWeight int   `mapstructure:"max_weight"`
+	Fee       int64 `mapstructure:"fee"`
+}
+
+// 배송 권역이 설정되지 않았으면 nil을 반환하며, 이 경우 배송비를 부과하지 않음
+func (c ShippingConfig) RateTable() *domain.ShippingRateTable {
+	if len(c.Zones) == 0 {
+		return nil
+	}
+
+	table := &domain.ShippingRateTable{
+		FreeShippingThreshold: c.FreeShippingThreshold,
+		VolumetricDivisor:     c.VolumetricDivisor,
+		DefaultZone:           c.DefaultZone,
+	}
+	for _, zone := range c.Zones {
+		shippingZone := domain.ShippingZone{Name: zone.Name, ZipPrefixes: zone.ZipPrefixes}
+		for _, rate := range zone.Rates {
+			shippingZone.Rates = append(shippingZone.Rates, domain.ShippingWeightRate{MaxWeight: rate.MaxWeight, Fee: rate.Fee})
+		}
+		table.Zones = append(table.Zones, shippingZone)
+	}
+	return table
+}
@@ -0,0 +1,16 @@
+package gateway
+
+import (
+	"errors"
+
+	domainGateway "github.com/HongJungWan/commerce-system/internal/domain/gateway"
+)
+
+// NewCarrierGateway 는 설정된 택배사에 맞는 구현을 반환합니다. 설정이 없으면 로컬용 택배사를 사용합니다.
+func NewCarrierGateway(provider string) (domainGateway.CarrierGateway, error) {
+	switch provider {
+	case fakeCarrier, "":
+		return NewFakeCarrierGateway(), nil
+	}
+	return nil, errors.New("지원하지 않는 택배사입니다: " + provider)
+}
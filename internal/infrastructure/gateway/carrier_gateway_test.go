@@ -0,0 +1,26 @@
+package gateway_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/gateway"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCarrierGateway_Success_DefaultsToFake(t *testing.T) {
+	// When
+	carrierGateway, err := gateway.NewCarrierGateway("")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "fake", carrierGateway.Carrier())
+}
+
+func TestNewCarrierGateway_Failure_Unsupported(t *testing.T) {
+	// When
+	carrierGateway, err := gateway.NewCarrierGateway("unknown")
+
+	// Then
+	assert.Nil(t, carrierGateway)
+	assert.EqualError(t, err, "지원하지 않는 택배사입니다: unknown")
+}
@@ -0,0 +1,65 @@
+package gateway
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	domainGateway "github.com/HongJungWan/commerce-system/internal/domain/gateway"
+	"github.com/google/uuid"
+)
+
+const (
+	fakeCarrier              = "fake"
+	fakeTrackingNumberPrefix = "FAKE-"
+)
+
+// 로컬 및 테스트 환경용 택배사. 운송장 번호에 프로세스 상태를 쓰지 않으므로 재시작 후에도 중복되거나 조회가 실패하지 않습니다.
+// SimulateDelivery로 배송 완료를 흉내낼 수 있습니다.
+type FakeCarrierGateway struct {
+	mu        sync.Mutex
+	delivered map[string]time.Time
+}
+
+func NewFakeCarrierGateway() *FakeCarrierGateway {
+	return &FakeCarrierGateway{delivered: make(map[string]time.Time)}
+}
+
+func (g *FakeCarrierGateway) Carrier() string {
+	return fakeCarrier
+}
+
+func (g *FakeCarrierGateway) Register(orderNumber string) (string, error) {
+	if orderNumber == "" {
+		return "", errors.New("주문번호가 누락되었습니다.")
+	}
+	return fakeTrackingNumberPrefix + orderNumber + "-" + uuid.New().String(), nil
+}
+
+func (g *FakeCarrierGateway) Track(trackingNumber string) (*domainGateway.TrackingInfo, error) {
+	if !strings.HasPrefix(trackingNumber, fakeTrackingNumberPrefix) {
+		return nil, errors.New("존재하지 않는 운송장입니다.")
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	deliveredAt, ok := g.delivered[trackingNumber]
+	if !ok {
+		return &domainGateway.TrackingInfo{}, nil
+	}
+	return &domainGateway.TrackingInfo{Delivered: true, DeliveredAt: &deliveredAt}, nil
+}
+
+func (g *FakeCarrierGateway) SimulateDelivery(trackingNumber string) error {
+	if !strings.HasPrefix(trackingNumber, fakeTrackingNumberPrefix) {
+		return errors.New("존재하지 않는 운송장입니다.")
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.delivered[trackingNumber] = time.Now()
+	return nil
+}
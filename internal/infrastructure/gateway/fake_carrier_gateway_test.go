@@ -0,0 +1,75 @@
+package gateway_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/gateway"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeCarrierGateway_Register_Success(t *testing.T) {
+	// Given
+	carrierGateway := gateway.NewFakeCarrierGateway()
+
+	// When
+	trackingNumber, err := carrierGateway.Register("O12345")
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(trackingNumber, "FAKE-O12345-"))
+}
+
+func TestFakeCarrierGateway_Register_Success_UniqueAcrossInstances(t *testing.T) {
+	// Given
+	first, _ := gateway.NewFakeCarrierGateway().Register("O12345")
+
+	// When
+	second, err := gateway.NewFakeCarrierGateway().Register("O12345")
+
+	// Then
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
+
+func TestFakeCarrierGateway_Track_Success_AfterRestart(t *testing.T) {
+	// Given
+	trackingNumber, _ := gateway.NewFakeCarrierGateway().Register("O12345")
+
+	// When
+	info, err := gateway.NewFakeCarrierGateway().Track(trackingNumber)
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, info.Delivered)
+}
+
+func TestFakeCarrierGateway_Track_SimulatedDelivery(t *testing.T) {
+	// Given
+	carrierGateway := gateway.NewFakeCarrierGateway()
+	trackingNumber, _ := carrierGateway.Register("O12345")
+	before, _ := carrierGateway.Track(trackingNumber)
+
+	// When
+	err := carrierGateway.SimulateDelivery(trackingNumber)
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, before.Delivered)
+	after, _ := carrierGateway.Track(trackingNumber)
+	assert.True(t, after.Delivered)
+	assert.NotNil(t, after.DeliveredAt)
+}
+
+func TestFakeCarrierGateway_Track_Failure_Unknown(t *testing.T) {
+	// Given
+	carrierGateway := gateway.NewFakeCarrierGateway()
+
+	// When
+	info, err := carrierGateway.Track("UNKNOWN")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, info)
+	assert.Equal(t, "존재하지 않는 운송장입니다.", err.Error())
+}
@@ -0,0 +1,66 @@
+package gateway
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	fakeProvider          = "fake"
+	fakeTransactionPrefix = "FAKE-"
+)
+
+// 로컬 및 테스트 환경용 결제 대행사. 외부 호출 없이 항상 같은 결과를 반환합니다.
+// 거래 상태를 메모리에 보관하지 않으므로 서버를 다시 시작해도 기존 주문의 매입과 환불이 가능하며,
+// 금액 한도 검증은 도메인의 결제 정보(Payment)에 맡깁니다.
+type FakePaymentGateway struct {
+	AuthorizationLimit int64 // 0보다 크면 이 금액을 초과하는 결제는 거절
+}
+
+func NewFakePaymentGateway() *FakePaymentGateway {
+	return &FakePaymentGateway{}
+}
+
+func (g *FakePaymentGateway) Provider() string {
+	return fakeProvider
+}
+
+func (g *FakePaymentGateway) Authorize(orderNumber string, amount int64) (string, error) {
+	if amount <= 0 {
+		return "", errors.New("결제 금액이 잘못되었습니다.")
+	}
+	if g.AuthorizationLimit > 0 && amount > g.AuthorizationLimit {
+		return "", errors.New("결제 승인이 거절되었습니다.")
+	}
+	return fakeTransactionPrefix + orderNumber, nil
+}
+
+func (g *FakePaymentGateway) Capture(transactionID string, amount int64) error {
+	if !strings.HasPrefix(transactionID, fakeTransactionPrefix) {
+		return errors.New("존재하지 않는 거래입니다.")
+	}
+	if amount <= 0 {
+		return errors.New("매입 금액이 잘못되었습니다.")
+	}
+	return nil
+}
+
+func (g *FakePaymentGateway) Void(transactionID string, amount int64) error {
+	if !strings.HasPrefix(transactionID, fakeTransactionPrefix) {
+		return errors.New("존재하지 않는 거래입니다.")
+	}
+	if amount <= 0 {
+		return errors.New("승인 취소 금액이 잘못되었습니다.")
+	}
+	return nil
+}
+
+func (g *FakePaymentGateway) Refund(transactionID string, amount int64) error {
+	if !strings.HasPrefix(transactionID, fakeTransactionPrefix) {
+		return errors.New("존재하지 않는 거래입니다.")
+	}
+	if amount <= 0 {
+		return errors.New("환불 금액이 잘못되었습니다.")
+	}
+	return nil
+}
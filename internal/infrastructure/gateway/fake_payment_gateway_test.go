@@ -0,0 +1,121 @@
+package gateway_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/gateway"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakePaymentGateway_Authorize_Success(t *testing.T) {
+	// Given
+	paymentGateway := gateway.NewFakePaymentGateway()
+
+	// When
+	transactionID, err := paymentGateway.Authorize("O12345", 3000)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "FAKE-O12345", transactionID)
+}
+
+func TestFakePaymentGateway_Authorize_Failure_OverLimit(t *testing.T) {
+	// Given
+	paymentGateway := gateway.NewFakePaymentGateway()
+	paymentGateway.AuthorizationLimit = 1000
+
+	// When
+	transactionID, err := paymentGateway.Authorize("O12345", 3000)
+
+	// Then
+	assert.Error(t, err)
+	assert.Empty(t, transactionID)
+	assert.Equal(t, "결제 승인이 거절되었습니다.", err.Error())
+}
+
+func TestFakePaymentGateway_Capture_Success(t *testing.T) {
+	// Given
+	paymentGateway := gateway.NewFakePaymentGateway()
+	transactionID, _ := paymentGateway.Authorize("O12345", 3000)
+
+	// When
+	err := paymentGateway.Capture(transactionID, 3000)
+
+	// Then
+	assert.NoError(t, err)
+}
+
+func TestFakePaymentGateway_Capture_Success_NewInstance(t *testing.T) {
+	// Given
+	transactionID, _ := gateway.NewFakePaymentGateway().Authorize("O12345", 3000)
+	restartedGateway := gateway.NewFakePaymentGateway()
+
+	// When
+	err := restartedGateway.Capture(transactionID, 3000)
+
+	// Then
+	assert.NoError(t, err)
+}
+
+func TestFakePaymentGateway_Capture_Failure_UnknownTransaction(t *testing.T) {
+	// Given
+	paymentGateway := gateway.NewFakePaymentGateway()
+
+	// When
+	err := paymentGateway.Capture("OTHER-unknown", 3000)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "존재하지 않는 거래입니다.", err.Error())
+}
+
+func TestFakePaymentGateway_Void_Success(t *testing.T) {
+	// Given
+	paymentGateway := gateway.NewFakePaymentGateway()
+	transactionID, _ := paymentGateway.Authorize("O12345", 3000)
+
+	// When
+	err := paymentGateway.Void(transactionID, 3000)
+
+	// Then
+	assert.NoError(t, err)
+}
+
+func TestFakePaymentGateway_Void_Failure_UnknownTransaction(t *testing.T) {
+	// Given
+	paymentGateway := gateway.NewFakePaymentGateway()
+
+	// When
+	err := paymentGateway.Void("UNKNOWN", 3000)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "존재하지 않는 거래입니다.", err.Error())
+}
+
+func TestFakePaymentGateway_Refund_Success(t *testing.T) {
+	// Given
+	paymentGateway := gateway.NewFakePaymentGateway()
+	transactionID, _ := paymentGateway.Authorize("O12345", 3000)
+
+	// When
+	errFirst := paymentGateway.Refund(transactionID, 1000)
+	errSecond := paymentGateway.Refund(transactionID, 2000)
+
+	// Then
+	assert.NoError(t, errFirst)
+	assert.NoError(t, errSecond)
+}
+
+func TestFakePaymentGateway_Refund_Failure_InvalidAmount(t *testing.T) {
+	// Given
+	paymentGateway := gateway.NewFakePaymentGateway()
+	transactionID, _ := paymentGateway.Authorize("O12345", 3000)
+
+	// When
+	err := paymentGateway.Refund(transactionID, 0)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "환불 금액이 잘못되었습니다.", err.Error())
+}
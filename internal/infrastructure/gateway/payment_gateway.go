@@ -0,0 +1,16 @@
+package gateway
+
+import (
+	"errors"
+
+	domainGateway "github.com/HongJungWan/commerce-system/internal/domain/gateway"
+)
+
+// NewPaymentGateway 는 설정된 결제 대행사에 맞는 구현을 반환합니다. 설정이 없으면 로컬용 대행사를 사용합니다.
+func NewPaymentGateway(provider string) (domainGateway.PaymentGateway, error) {
+	switch provider {
+	case fakeProvider, "":
+		return NewFakePaymentGateway(), nil
+	}
+	return nil, errors.New("지원하지 않는 결제 대행사입니다: " + provider)
+}
@@ -0,0 +1,35 @@
+package gateway_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/gateway"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPaymentGateway_Success_Fake(t *testing.T) {
+	// When
+	paymentGateway, err := gateway.NewPaymentGateway("fake")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "fake", paymentGateway.Provider())
+}
+
+func TestNewPaymentGateway_Success_DefaultsToFake(t *testing.T) {
+	// When
+	paymentGateway, err := gateway.NewPaymentGateway("")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "fake", paymentGateway.Provider())
+}
+
+func TestNewPaymentGateway_Failure_Unsupported(t *testing.T) {
+	// When
+	paymentGateway, err := gateway.NewPaymentGateway("unknown")
+
+	// Then
+	assert.Nil(t, paymentGateway)
+	assert.EqualError(t, err, "지원하지 않는 결제 대행사입니다: unknown")
+}
@@ -0,0 +1,251 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"gorm.io/gorm"
+)
+
+// Run 은 AutoMigrate로 처리할 수 없는 기존 데이터 변환을 순서대로 실행합니다.
+// 각 단계는 변환 대상 컬럼이나 데이터가 남아 있을 때만 실행되므로 서버를 다시 시작해도 안전합니다.
+func Run(db *gorm.DB) error {
+	if err := migrateLegacyOrderItems(db); err != nil {
+		return err
+	}
+	if err := migrateLegacyOrderStatus(db); err != nil {
+		return err
+	}
+	if err := migrateVariantPriceOverrides(db); err != nil {
+		return err
+	}
+	if err := migrateLegacyProductCategories(db); err != nil {
+		return err
+	}
+	return migrateVariantCategories(db)
+}
+
+// migrateLegacyOrderItems 는 단일 상품 주문 시절 주문 테이블에 있던 상품번호, 가격, 수량을
+// 주문 상품 테이블로 옮긴 뒤 해당 컬럼을 삭제합니다. NOT NULL 컬럼이 남아 있으면 새 주문을 저장할 수 없습니다.
+func migrateLegacyOrderItems(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasColumn(&domain.Order{}, "product_number") {
+		return nil
+	}
+
+	orders, err := quotedTable(db, &domain.Order{})
+	if err != nil {
+		return err
+	}
+	items, err := quotedTable(db, &domain.OrderItem{})
+	if err != nil {
+		return err
+	}
+	products, err := quotedTable(db, &domain.Product{})
+	if err != nil {
+		return err
+	}
+
+	// 주문 당시 상품명은 저장되어 있지 않으므로 현재 상품명으로 채움
+	if err := db.Exec(fmt.Sprintf(`INSERT INTO %s (order_id, product_number, product_name, price, quantity, line_total)
+		SELECT o.id, o.product_number, COALESCE(p.product_name, ''), o.price, o.quantity, o.price * o.quantity
+		FROM %s o
+		LEFT JOIN %s p ON p.product_number = o.product_number
+		WHERE NOT EXISTS (SELECT 1 FROM %s i WHERE i.order_id = o.id)`, items, orders, products, items)).Error; err != nil {
+		return err
+	}
+
+	for _, column := range []string{"product_number", "price", "quantity"} {
+		if err := migrator.DropColumn(&domain.Order{}, column); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateLegacyOrderStatus 는 is_canceled 플래그로 취소를 표시하던 주문을 canceled 상태로, 나머지 주문을 delivered 상태로 옮긴 뒤
+// 플래그 컬럼을 삭제합니다. 옮기지 않으면 지난 주문이 기본값인 pending 상태가 되어 다시 취소하고 재고를 중복 복원할 수 있습니다.
+func migrateLegacyOrderStatus(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasColumn(&domain.Order{}, "is_canceled") {
+		return nil
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		canceledOrderIDs := tx.Model(&domain.Order{}).Select("id").Where("is_canceled = ?", true)
+
+		// 취소 금액과 상품별 취소 수량도 현재 전체 취소와 같은 형태로 채움
+		if err := tx.Model(&domain.OrderItem{}).Where("order_id IN (?)", canceledOrderIDs).
+			UpdateColumn("canceled_quantity", gorm.Expr("quantity")).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&domain.Order{}).Where("is_canceled = ?", true).
+			UpdateColumns(map[string]interface{}{
+				"status":          domain.OrderStatusCanceled,
+				"canceled_amount": gorm.Expr("total_amount"),
+			}).Error; err != nil {
+			return err
+		}
+
+		// 상태 없이 처리되던 지난 주문은 배송까지 끝난 것으로 간주
+		return tx.Model(&domain.Order{}).Where("is_canceled = ? OR is_canceled IS NULL", false).
+			UpdateColumn("status", domain.OrderStatusDelivered).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	return migrator.DropColumn(&domain.Order{}, "is_canceled")
+}
+
+// migrateVariantPriceOverrides 는 옵션 상품 전용 가격 컬럼이 생기기 전에 상위 상품과 다른 가격으로 만든 옵션 상품의 가격을 전용 가격으로 옮깁니다.
+// 옮기지 않으면 이런 옵션 상품이 상위 상품 가격을 따르게 되어 가격이 바뀝니다.
+// 상위 상품 가격을 따르는 옵션 상품은 저장된 가격이 상위 상품과 같게 유지되므로 다시 실행해도 바뀌지 않습니다.
+func migrateVariantPriceOverrides(db *gorm.DB) error {
+	var variants []*domain.Product
+	if err := db.Where("parent_product_number <> '' AND price_override IS NULL").Find(&variants).Error; err != nil {
+		return err
+	}
+	if len(variants) == 0 {
+		return nil
+	}
+
+	parentProductNumbers := make([]string, 0, len(variants))
+	for _, variant := range variants {
+		parentProductNumbers = append(parentProductNumbers, variant.ParentProductNumber)
+	}
+	var parents []*domain.Product
+	if err := db.Where("product_number IN ?", parentProductNumbers).Find(&parents).Error; err != nil {
+		return err
+	}
+	parentPrices := make(map[string]int64, len(parents))
+	for _, parent := range parents {
+		parentPrices[parent.ProductNumber] = parent.Price
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, variant := range variants {
+			parentPrice, ok := parentPrices[variant.ParentProductNumber]
+			if !ok || parentPrice == variant.Price {
+				continue
+			}
+			if err := tx.Model(&domain.Product{}).Where("id = ?", variant.ID).
+				UpdateColumn("price_override", variant.Price).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+var nonSlugCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+// migrateLegacyProductCategories 는 카테고리 테이블이 생기기 전에 자유 입력으로 저장한 상품 카테고리를 카테고리로 등록하고
+// 상품의 카테고리 기본 키와 슬러그를 채웁니다. 옮기지 않으면 카테고리 조회와 하위 카테고리 검색에서 이 상품들이 빠집니다.
+// 같은 슬러그의 카테고리가 이미 있으면 그 카테고리를 사용하며, 카테고리 기본 키가 채워진 상품은 다시 실행해도 바뀌지 않습니다.
+func migrateLegacyProductCategories(db *gorm.DB) error {
+	var names []string
+	if err := db.Model(&domain.Product{}).Distinct("category").
+		Where("category_id IS NULL AND category <> ''").Pluck("category", &names).Error; err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, name := range names {
+			slug := legacyCategorySlug(name)
+			var category domain.Category
+			err := tx.Where("slug = ?", slug).First(&category).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				category = domain.Category{Name: strings.TrimSpace(name), Slug: slug}
+				if err := category.Validate(); err != nil {
+					return err
+				}
+				err = tx.Create(&category).Error
+			}
+			if err != nil {
+				return err
+			}
+
+			if err := tx.Model(&domain.Product{}).Where("category_id IS NULL AND category = ?", name).
+				UpdateColumns(map[string]interface{}{"category_id": category.ID, "category": category.Slug}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// legacyCategorySlug 는 자유 입력 카테고리에서 슬러그를 만듭니다.
+// 과세 분류, 쿠폰, 취소 정책 설정이 기존 값을 그대로 가리킬 수 있도록 슬러그 형식에 맞는 값은 바꾸지 않으며,
+// 영문과 숫자가 없는 값(예: 한글 카테고리명)은 카테고리명의 해시로 슬러그를 만듭니다.
+func legacyCategorySlug(name string) string {
+	slug := strings.Trim(nonSlugCharacters.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if slug == "" {
+		hash := fnv.New32a()
+		_, _ = hash.Write([]byte(name))
+		slug = fmt.Sprintf("category-%08x", hash.Sum32())
+	}
+	return slug
+}
+
+// migrateVariantCategories 는 상위 상품과 다른 카테고리로 저장된 옵션 상품의 카테고리를 상위 상품의 카테고리로 맞춥니다.
+// 옵션 상품은 상위 상품의 카테고리를 따르므로, 맞추지 않으면 같은 상품의 옵션마다 과세 분류나 쿠폰 적용이 달라집니다.
+func migrateVariantCategories(db *gorm.DB) error {
+	var variants []*domain.Product
+	if err := db.Where("parent_product_number <> ''").Find(&variants).Error; err != nil {
+		return err
+	}
+	if len(variants) == 0 {
+		return nil
+	}
+
+	parentProductNumbers := make([]string, 0, len(variants))
+	for _, variant := range variants {
+		parentProductNumbers = append(parentProductNumbers, variant.ParentProductNumber)
+	}
+	var parents []*domain.Product
+	if err := db.Where("product_number IN ?", parentProductNumbers).Find(&parents).Error; err != nil {
+		return err
+	}
+	parentsByNumber := make(map[string]*domain.Product, len(parents))
+	for _, parent := range parents {
+		parentsByNumber[parent.ProductNumber] = parent
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, variant := range variants {
+			parent, ok := parentsByNumber[variant.ParentProductNumber]
+			if !ok || sameCategory(parent, variant) {
+				continue
+			}
+			if err := tx.Model(&domain.Product{}).Where("id = ?", variant.ID).
+				UpdateColumns(map[string]interface{}{"category_id": parent.CategoryID, "category": parent.Category}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func sameCategory(a, b *domain.Product) bool {
+	if a.Category != b.Category || (a.CategoryID == nil) != (b.CategoryID == nil) {
+		return false
+	}
+	return a.CategoryID == nil || *a.CategoryID == *b.CategoryID
+}
+
+// quotedTable 은 설정된 네이밍 전략에 따른 모델의 테이블명을 인용 부호로 감싸 반환합니다.
+func quotedTable(db *gorm.DB, model interface{}) (string, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(model); err != nil {
+		return "", err
+	}
+	return db.Statement.Quote(stmt.Schema.Table), nil
+}
@@ -0,0 +1,234 @@
+package migration_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/migration"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// legacyOrder 는 단일 상품 주문 시절의 주문 테이블 스키마입니다.
+type legacyOrder struct {
+	ID            int       `gorm:"primaryKey;autoIncrement"`
+	OrderNumber   string    `gorm:"unique;not null"`
+	OrderDate     time.Time `gorm:"not null"`
+	MemberNumber  string    `gorm:"not null"`
+	ProductNumber string    `gorm:"not null"`
+	Price         int64     `gorm:"not null"`
+	Quantity      int       `gorm:"not null"`
+	TotalAmount   int64     `gorm:"not null"`
+	IsCanceled    bool      `gorm:"default:false"`
+	CanceledAt    *time.Time
+}
+
+func (legacyOrder) TableName() string {
+	return "order"
+}
+
+// setupLegacyDB 는 기존 스키마로 저장된 주문 위에 현재 스키마를 AutoMigrate 한 데이터베이스를 반환합니다.
+// 운영 환경과 같이 단수형 테이블명을 사용합니다.
+func setupLegacyDB(t *testing.T, orders ...*legacyOrder) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		NamingStrategy: schema.NamingStrategy{SingularTable: true},
+	})
+	assert.NoError(t, err)
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+
+	assert.NoError(t, db.AutoMigrate(&domain.Category{}, &domain.Product{}, &legacyOrder{}))
+	for _, order := range orders {
+		assert.NoError(t, db.Create(order).Error)
+	}
+	assert.NoError(t, db.AutoMigrate(&domain.Member{}, &domain.Order{}, &domain.OrderItem{}, &domain.OrderCancellation{}, &domain.Payment{}))
+	return db
+}
+
+func TestRun_Success_MigratesLegacyOrderItems(t *testing.T) {
+	// Given
+	db := setupLegacyDB(t, &legacyOrder{
+		OrderNumber:   "O12345",
+		OrderDate:     time.Now(),
+		MemberNumber:  "M12345",
+		ProductNumber: "P12345",
+		Price:         1000,
+		Quantity:      3,
+		TotalAmount:   3000,
+	})
+	_ = db.Create(&domain.Product{ProductNumber: "P12345", ProductName: "Pizza", Price: 1200})
+
+	// When
+	err := migration.Run(db)
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, db.Migrator().HasColumn(&domain.Order{}, "product_number"))
+	assert.False(t, db.Migrator().HasColumn(&domain.Order{}, "quantity"))
+
+	orderRepo := repository.NewOrderRepository(db)
+	order, err := orderRepo.GetByOrderNumber("O12345")
+	assert.NoError(t, err)
+	assert.Len(t, order.Items, 1)
+	assert.Equal(t, "P12345", order.Items[0].ProductNumber)
+	assert.Equal(t, "Pizza", order.Items[0].ProductName)
+	assert.EqualValues(t, 1000, order.Items[0].Price)
+	assert.Equal(t, 3, order.Items[0].Quantity)
+	assert.EqualValues(t, 3000, order.Items[0].LineTotal)
+
+	newOrder := &domain.Order{
+		OrderNumber:  "O12346",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1200, Quantity: 1, LineTotal: 1200}},
+		TotalAmount:  1200,
+	}
+	assert.NoError(t, orderRepo.Create(newOrder))
+}
+
+func TestRun_Success_Idempotent(t *testing.T) {
+	// Given
+	db := setupLegacyDB(t, &legacyOrder{
+		OrderNumber:   "O12345",
+		OrderDate:     time.Now(),
+		MemberNumber:  "M12345",
+		ProductNumber: "P12345",
+		Price:         1000,
+		Quantity:      1,
+		TotalAmount:   1000,
+	})
+	_ = migration.Run(db)
+
+	// When
+	err := migration.Run(db)
+
+	// Then
+	assert.NoError(t, err)
+	var count int64
+	db.Model(&domain.OrderItem{}).Count(&count)
+	assert.EqualValues(t, 1, count)
+}
+
+func TestRun_Success_MigratesCanceledFlagToStatus(t *testing.T) {
+	// Given
+	canceledAt := time.Now()
+	db := setupLegacyDB(t,
+		&legacyOrder{
+			OrderNumber:   "O12345",
+			OrderDate:     time.Now(),
+			MemberNumber:  "M12345",
+			ProductNumber: "P12345",
+			Price:         1000,
+			Quantity:      2,
+			TotalAmount:   2000,
+			IsCanceled:    true,
+			CanceledAt:    &canceledAt,
+		},
+		&legacyOrder{
+			OrderNumber:   "O12346",
+			OrderDate:     time.Now(),
+			MemberNumber:  "M12345",
+			ProductNumber: "P12345",
+			Price:         1000,
+			Quantity:      1,
+			TotalAmount:   1000,
+		},
+	)
+
+	// When
+	err := migration.Run(db)
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, db.Migrator().HasColumn(&domain.Order{}, "is_canceled"))
+
+	orderRepo := repository.NewOrderRepository(db)
+	canceledOrder, _ := orderRepo.GetByOrderNumber("O12345")
+	assert.Equal(t, domain.OrderStatusCanceled, canceledOrder.Status)
+	assert.EqualValues(t, 2000, canceledOrder.CanceledAmount)
+	assert.Equal(t, 2, canceledOrder.Items[0].CanceledQuantity)
+	assert.EqualError(t, canceledOrder.Cancel(), "이미 취소된 주문입니다.")
+
+	activeOrder, _ := orderRepo.GetByOrderNumber("O12346")
+	assert.Equal(t, domain.OrderStatusDelivered, activeOrder.Status)
+	assert.Equal(t, 0, activeOrder.Items[0].CanceledQuantity)
+	assert.EqualError(t, activeOrder.Cancel(), "배송이 시작된 주문은 취소할 수 없습니다.")
+}
+
+func TestRun_Success_RecordsVariantPriceOverrides(t *testing.T) {
+	// Given
+	db := setupLegacyDB(t)
+	_ = db.Create(&domain.Product{ProductNumber: "P12345", ProductName: "T-Shirt", Price: 10000})
+	_ = db.Create(&domain.Product{ProductNumber: "P12346", ProductName: "T-Shirt (S)", Price: 10000, ParentProductNumber: "P12345", SKU: "TSHIRT-S"})
+	_ = db.Create(&domain.Product{ProductNumber: "P12347", ProductName: "T-Shirt (M)", Price: 12000, ParentProductNumber: "P12345", SKU: "TSHIRT-M"})
+
+	// When
+	err := migration.Run(db)
+
+	// Then
+	assert.NoError(t, err)
+	productRepo := repository.NewProductRepository(db)
+	inherited, _ := productRepo.GetBySKU("TSHIRT-S")
+	assert.Nil(t, inherited.PriceOverride)
+	overridden, _ := productRepo.GetBySKU("TSHIRT-M")
+	assert.NotNil(t, overridden.PriceOverride)
+	assert.EqualValues(t, 12000, *overridden.PriceOverride)
+}
+
+func TestRun_Success_MigratesLegacyProductCategories(t *testing.T) {
+	// Given
+	db := setupLegacyDB(t)
+	_ = db.Create(&domain.Category{Name: "식품", Slug: "food"})
+	_ = db.Create(&domain.Product{ProductNumber: "P12345", ProductName: "Pizza", Category: "food", Price: 1000})
+	_ = db.Create(&domain.Product{ProductNumber: "P12346", ProductName: "T-Shirt", Category: "Men's Clothing", Price: 10000})
+	_ = db.Create(&domain.Product{ProductNumber: "P12347", ProductName: "Kimchi", Category: "반찬", Price: 5000})
+	_ = db.Create(&domain.Product{ProductNumber: "P12348", ProductName: "Gift Card", Price: 5000})
+
+	// When
+	err := migration.Run(db)
+
+	// Then
+	assert.NoError(t, err)
+	assert.NoError(t, migration.Run(db))
+	categoryRepo := repository.NewCategoryRepository(db)
+	categories, _ := categoryRepo.GetAll()
+	assert.Len(t, categories, 3)
+
+	productRepo := repository.NewProductRepository(db)
+	pizza, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, "food", pizza.Category)
+	assert.Equal(t, categories[0].ID, *pizza.CategoryID)
+	shirt, _ := productRepo.GetByProductNumber("P12346")
+	assert.Equal(t, "men-s-clothing", shirt.Category)
+	assert.NotNil(t, shirt.CategoryID)
+	kimchi, _ := productRepo.GetByProductNumber("P12347")
+	assert.Regexp(t, `^category-[0-9a-f]{8}$`, kimchi.Category)
+	kimchiCategory, _ := categoryRepo.GetById(*kimchi.CategoryID)
+	assert.Equal(t, "반찬", kimchiCategory.Name)
+	giftCard, _ := productRepo.GetByProductNumber("P12348")
+	assert.Nil(t, giftCard.CategoryID)
+}
+
+func TestRun_Success_VariantsFollowParentCategory(t *testing.T) {
+	// Given
+	db := setupLegacyDB(t)
+	food := &domain.Category{Name: "식품", Slug: "food"}
+	clothing := &domain.Category{Name: "의류", Slug: "clothing"}
+	_ = db.Create(food)
+	_ = db.Create(clothing)
+	_ = db.Create(&domain.Product{ProductNumber: "P12345", ProductName: "T-Shirt", CategoryID: &clothing.ID, Category: "clothing", Price: 10000})
+	_ = db.Create(&domain.Product{ProductNumber: "P12346", ProductName: "T-Shirt (S)", CategoryID: &food.ID, Category: "food", Price: 10000, ParentProductNumber: "P12345", SKU: "TSHIRT-S"})
+
+	// When
+	err := migration.Run(db)
+
+	// Then
+	assert.NoError(t, err)
+	variant, _ := repository.NewProductRepository(db).GetBySKU("TSHIRT-S")
+	assert.Equal(t, "clothing", variant.Category)
+	assert.Equal(t, clothing.ID, *variant.CategoryID)
+}
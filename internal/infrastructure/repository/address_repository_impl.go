@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	domainRepository "github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type AddressRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAddressRepository(db *gorm.DB) *AddressRepositoryImpl {
+	return &AddressRepositoryImpl{db: db}
+}
+
+func (r *AddressRepositoryImpl) WithTx(tx *gorm.DB) domainRepository.AddressRepository {
+	return &AddressRepositoryImpl{db: tx}
+}
+
+func (r *AddressRepositoryImpl) Create(address *domain.Address) error {
+	return r.db.Create(address).Error
+}
+
+func (r *AddressRepositoryImpl) GetById(id int) (*domain.Address, error) {
+	var address domain.Address
+	if err := r.db.First(&address, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
+
+func (r *AddressRepositoryImpl) GetByMemberNumber(memberNumber string) ([]*domain.Address, error) {
+	var addresses []*domain.Address
+	if err := r.db.Where("member_number = ?", memberNumber).Order("is_default DESC, id ASC").Find(&addresses).Error; err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+func (r *AddressRepositoryImpl) GetDefault(memberNumber string) (*domain.Address, error) {
+	var address domain.Address
+	if err := r.db.First(&address, "member_number = ? AND is_default = ?", memberNumber, true).Error; err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
+
+func (r *AddressRepositoryImpl) CountByMemberNumber(memberNumber string) (int64, error) {
+	var count int64
+	if err := r.db.Model(&domain.Address{}).Where("member_number = ?", memberNumber).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *AddressRepositoryImpl) ClearDefault(memberNumber string) error {
+	return r.db.Model(&domain.Address{}).
+		Where("member_number = ? AND is_default = ?", memberNumber, true).
+		Update("is_default", false).Error
+}
+
+func (r *AddressRepositoryImpl) Update(address *domain.Address) error {
+	return r.db.Save(address).Error
+}
+
+func (r *AddressRepositoryImpl) Delete(id int) error {
+	return r.db.Delete(&domain.Address{}, "id = ?", id).Error
+}
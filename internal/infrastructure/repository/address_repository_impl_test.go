@@ -0,0 +1,56 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRepositoryTestAddress(isDefault bool) *domain.Address {
+	return &domain.Address{
+		MemberNumber: "M12345",
+		Label:        "집",
+		PostalAddress: domain.PostalAddress{
+			RecipientName: "홍길동",
+			Phone:         "010-1234-5678",
+			ZipCode:       "06236",
+			BaseAddress:   "서울특별시 강남구 테헤란로 123",
+		},
+		IsDefault: isDefault,
+	}
+}
+
+func TestAddressRepositoryImpl_GetDefault_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewAddressRepository(db)
+	_ = repo.Create(newRepositoryTestAddress(false))
+	defaultAddress := newRepositoryTestAddress(true)
+	_ = repo.Create(defaultAddress)
+
+	// When
+	address, err := repo.GetDefault("M12345")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, defaultAddress.ID, address.ID)
+	assert.Equal(t, "06236", address.PostalAddress.ZipCode)
+}
+
+func TestAddressRepositoryImpl_ClearDefault_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewAddressRepository(db)
+	_ = repo.Create(newRepositoryTestAddress(true))
+
+	// When
+	err := repo.ClearDefault("M12345")
+
+	// Then
+	assert.NoError(t, err)
+	_, err = repo.GetDefault("M12345")
+	assert.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	domainRepository "github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type CartRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewCartRepository(db *gorm.DB) *CartRepositoryImpl {
+	return &CartRepositoryImpl{db: db}
+}
+
+func (r *CartRepositoryImpl) WithTx(tx *gorm.DB) domainRepository.CartRepository {
+	return &CartRepositoryImpl{db: tx}
+}
+
+func (r *CartRepositoryImpl) GetByMemberNumber(memberNumber string) (*domain.Cart, error) {
+	var cart domain.Cart
+	if err := r.db.Preload("Items").First(&cart, "member_number = ?", memberNumber).Error; err != nil {
+		return nil, err
+	}
+	return &cart, nil
+}
+
+func (r *CartRepositoryImpl) Save(cart *domain.Cart) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		items := cart.Items
+
+		// 장바구니 상품은 매번 현재 상태로 교체
+		if err := tx.Omit("Items").Save(cart).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("cart_id = ?", cart.ID).Delete(&domain.CartItem{}).Error; err != nil {
+			return err
+		}
+		for i := range items {
+			items[i].ID = 0
+			items[i].CartID = cart.ID
+		}
+		if len(items) > 0 {
+			if err := tx.Create(&items).Error; err != nil {
+				return err
+			}
+		}
+		cart.Items = items
+		return nil
+	})
+}
@@ -0,0 +1,70 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCartRepositoryImpl_Save_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewCartRepository(db)
+	cart := &domain.Cart{MemberNumber: "M12345"}
+	_ = cart.AddItem("P12345", 2)
+	_ = cart.AddItem("P12346", 1)
+
+	// When
+	err := repo.Save(cart)
+
+	// Then
+	assert.NoError(t, err)
+	assert.NotZero(t, cart.ID)
+
+	savedCart, err := repo.GetByMemberNumber("M12345")
+	assert.NoError(t, err)
+	assert.Len(t, savedCart.Items, 2)
+}
+
+func TestCartRepositoryImpl_Save_Success_ReplaceItems(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewCartRepository(db)
+	cart := &domain.Cart{MemberNumber: "M12345"}
+	_ = cart.AddItem("P12345", 2)
+	_ = cart.AddItem("P12346", 1)
+	_ = repo.Save(cart)
+
+	// When
+	_ = cart.RemoveItem("P12345")
+	_ = cart.UpdateItem("P12346", 4)
+	err := repo.Save(cart)
+
+	// Then
+	assert.NoError(t, err)
+
+	savedCart, _ := repo.GetByMemberNumber("M12345")
+	assert.Len(t, savedCart.Items, 1)
+	assert.Equal(t, "P12346", savedCart.Items[0].ProductNumber)
+	assert.Equal(t, 4, savedCart.Items[0].Quantity)
+
+	var itemCount int64
+	db.Model(&domain.CartItem{}).Count(&itemCount)
+	assert.EqualValues(t, 1, itemCount)
+}
+
+func TestCartRepositoryImpl_GetByMemberNumber_Failure_NotFound(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewCartRepository(db)
+
+	// When
+	cart, err := repo.GetByMemberNumber("nonexistent")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, cart)
+}
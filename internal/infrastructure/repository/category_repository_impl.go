@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	domainRepository "github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type CategoryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewCategoryRepository(db *gorm.DB) *CategoryRepositoryImpl {
+	return &CategoryRepositoryImpl{db: db}
+}
+
+func (r *CategoryRepositoryImpl) WithTx(tx *gorm.DB) domainRepository.CategoryRepository {
+	return &CategoryRepositoryImpl{db: tx}
+}
+
+func (r *CategoryRepositoryImpl) Create(category *domain.Category) error {
+	return r.db.Create(category).Error
+}
+
+func (r *CategoryRepositoryImpl) GetAll() ([]*domain.Category, error) {
+	var categories []*domain.Category
+	if err := r.db.Order("sort_order ASC").Order("id ASC").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+func (r *CategoryRepositoryImpl) GetById(id int) (*domain.Category, error) {
+	var category domain.Category
+	if err := r.db.First(&category, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (r *CategoryRepositoryImpl) GetBySlug(slug string) (*domain.Category, error) {
+	var category domain.Category
+	if err := r.db.First(&category, "slug = ?", slug).Error; err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (r *CategoryRepositoryImpl) Update(category *domain.Category) error {
+	return r.db.Save(category).Error
+}
+
+func (r *CategoryRepositoryImpl) Delete(id int) error {
+	return r.db.Delete(&domain.Category{}, "id = ?", id).Error
+}
+
+// CountProducts 는 보관 처리된 상품을 포함해 카테고리에 등록된 상품 수를 조회합니다.
+func (r *CategoryRepositoryImpl) CountProducts(id int) (int64, error) {
+	var count int64
+	if err := r.db.Model(&domain.Product{}).Where("category_id = ?", id).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
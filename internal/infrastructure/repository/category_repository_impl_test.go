@@ -0,0 +1,59 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategoryRepositoryImpl_GetAll_Success_SortOrder(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewCategoryRepository(db)
+	_ = repo.Create(&domain.Category{Name: "의류", Slug: "clothing", SortOrder: 2})
+	_ = repo.Create(&domain.Category{Name: "식품", Slug: "food", SortOrder: 1})
+
+	// When
+	categories, err := repo.GetAll()
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, categories, 2)
+	assert.Equal(t, "food", categories[0].Slug)
+	assert.Equal(t, "clothing", categories[1].Slug)
+}
+
+func TestCategoryRepositoryImpl_Create_Failure_DuplicateSlug(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewCategoryRepository(db)
+	_ = repo.Create(&domain.Category{Name: "식품", Slug: "food"})
+
+	// When
+	err := repo.Create(&domain.Category{Name: "음식", Slug: "food"})
+
+	// Then
+	assert.Error(t, err)
+}
+
+func TestCategoryRepositoryImpl_CountProducts_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewCategoryRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	category := &domain.Category{Name: "식품", Slug: "food"}
+	_ = repo.Create(category)
+	product := &domain.Product{ProductNumber: "P12345", ProductName: "Pizza", Price: 1000}
+	product.AssignCategory(category)
+	_ = productRepo.Create(product)
+
+	// When
+	count, err := repo.CountProducts(category.ID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	domainRepository "github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CouponRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewCouponRepository(db *gorm.DB) *CouponRepositoryImpl {
+	return &CouponRepositoryImpl{db: db}
+}
+
+func (r *CouponRepositoryImpl) WithTx(tx *gorm.DB) domainRepository.CouponRepository {
+	return &CouponRepositoryImpl{db: tx}
+}
+
+func (r *CouponRepositoryImpl) Create(coupon *domain.Coupon) error {
+	return r.db.Create(coupon).Error
+}
+
+func (r *CouponRepositoryImpl) GetAll() ([]*domain.Coupon, error) {
+	var coupons []*domain.Coupon
+	if err := r.db.Order("id DESC").Find(&coupons).Error; err != nil {
+		return nil, err
+	}
+	return coupons, nil
+}
+
+func (r *CouponRepositoryImpl) GetById(id int) (*domain.Coupon, error) {
+	var coupon domain.Coupon
+	if err := r.db.First(&coupon, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &coupon, nil
+}
+
+func (r *CouponRepositoryImpl) GetByCode(code string) (*domain.Coupon, error) {
+	var coupon domain.Coupon
+	if err := r.db.First(&coupon, "code = ?", code).Error; err != nil {
+		return nil, err
+	}
+	return &coupon, nil
+}
+
+// GetByIdForUpdate 는 트랜잭션이 끝날 때까지 다른 쿠폰 사용을 막도록 쿠폰 행을 잠그고 조회합니다.
+func (r *CouponRepositoryImpl) GetByIdForUpdate(id int) (*domain.Coupon, error) {
+	var coupon domain.Coupon
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&coupon, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &coupon, nil
+}
+
+func (r *CouponRepositoryImpl) Update(coupon *domain.Coupon) error {
+	// 사용 횟수는 Use, ReleaseUsage에서만 변경
+	return r.db.Omit("used_count").Save(coupon).Error
+}
+
+func (r *CouponRepositoryImpl) Delete(id int) error {
+	return r.db.Delete(&domain.Coupon{}, "id = ?", id).Error
+}
+
+func (r *CouponRepositoryImpl) CountUsageByMember(couponID int, memberNumber string) (int64, error) {
+	var count int64
+	if err := r.db.Model(&domain.CouponUsage{}).
+		Where("coupon_id = ? AND member_number = ?", couponID, memberNumber).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *CouponRepositoryImpl) Use(coupon *domain.Coupon, usage *domain.CouponUsage) error {
+	// 사용 한도 안에서만 사용 횟수를 증가시켜 동시 사용을 막음
+	result := r.db.Model(&domain.Coupon{}).
+		Where("id = ? AND (usage_limit = 0 OR used_count < usage_limit)", coupon.ID).
+		UpdateColumn("used_count", gorm.Expr("used_count + ?", 1))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrCouponExhausted
+	}
+	coupon.UsedCount++
+	usage.CouponID = coupon.ID
+	return r.db.Create(usage).Error
+}
+
+func (r *CouponRepositoryImpl) ReleaseUsage(orderID int) error {
+	var usage domain.CouponUsage
+	err := r.db.First(&usage, "order_id = ?", orderID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := r.db.Model(&domain.Coupon{}).
+		Where("id = ? AND used_count > 0", usage.CouponID).
+		UpdateColumn("used_count", gorm.Expr("used_count - ?", 1)).Error; err != nil {
+		return err
+	}
+	return r.db.Delete(&usage).Error
+}
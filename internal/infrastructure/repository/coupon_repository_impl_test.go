@@ -0,0 +1,135 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func newRepositoryTestCoupon(usageLimit int) *domain.Coupon {
+	return &domain.Coupon{
+		Code:          "WELCOME10",
+		Name:          "신규 회원 10% 할인",
+		DiscountType:  domain.CouponDiscountTypePercentage,
+		DiscountValue: 10,
+		StartsAt:      time.Now().Add(-time.Hour),
+		EndsAt:        time.Now().Add(time.Hour),
+		UsageLimit:    usageLimit,
+		Categories:    []string{"food"},
+	}
+}
+
+func TestCouponRepositoryImpl_Create_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewCouponRepository(db)
+	coupon := newRepositoryTestCoupon(0)
+
+	// When
+	err := repo.Create(coupon)
+
+	// Then
+	assert.NoError(t, err)
+	savedCoupon, err := repo.GetByCode("WELCOME10")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"food"}, savedCoupon.Categories)
+}
+
+func TestCouponRepositoryImpl_GetByIdForUpdate_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewCouponRepository(db)
+	coupon := newRepositoryTestCoupon(0)
+	_ = repo.Create(coupon)
+
+	// When
+	var lockedCoupon *domain.Coupon
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		lockedCoupon, err = repo.WithTx(tx).GetByIdForUpdate(coupon.ID)
+		return err
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "WELCOME10", lockedCoupon.Code)
+}
+
+func TestCouponRepositoryImpl_Use_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewCouponRepository(db)
+	coupon := newRepositoryTestCoupon(2)
+	_ = repo.Create(coupon)
+
+	// When
+	err := repo.Use(coupon, &domain.CouponUsage{MemberNumber: "M12345", OrderID: 1, DiscountAmount: 100, UsedAt: time.Now()})
+
+	// Then
+	assert.NoError(t, err)
+	savedCoupon, _ := repo.GetById(coupon.ID)
+	assert.Equal(t, 1, savedCoupon.UsedCount)
+	count, _ := repo.CountUsageByMember(coupon.ID, "M12345")
+	assert.EqualValues(t, 1, count)
+}
+
+func TestCouponRepositoryImpl_Use_Failure_Exhausted(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewCouponRepository(db)
+	coupon := newRepositoryTestCoupon(1)
+	_ = repo.Create(coupon)
+	_ = repo.Use(coupon, &domain.CouponUsage{MemberNumber: "M12345", OrderID: 1, DiscountAmount: 100, UsedAt: time.Now()})
+
+	// When
+	err := repo.Use(coupon, &domain.CouponUsage{MemberNumber: "M12346", OrderID: 2, DiscountAmount: 100, UsedAt: time.Now()})
+
+	// Then
+	assert.ErrorIs(t, err, domain.ErrCouponExhausted)
+}
+
+func TestCouponRepositoryImpl_ReleaseUsage_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewCouponRepository(db)
+	coupon := newRepositoryTestCoupon(1)
+	_ = repo.Create(coupon)
+	_ = repo.Use(coupon, &domain.CouponUsage{MemberNumber: "M12345", OrderID: 1, DiscountAmount: 100, UsedAt: time.Now()})
+
+	// When
+	err := repo.ReleaseUsage(1)
+
+	// Then
+	assert.NoError(t, err)
+	savedCoupon, _ := repo.GetById(coupon.ID)
+	assert.Equal(t, 0, savedCoupon.UsedCount)
+	count, _ := repo.CountUsageByMember(coupon.ID, "M12345")
+	assert.EqualValues(t, 0, count)
+}
+
+func TestCouponRepositoryImpl_Update_Success_KeepsUsedCount(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewCouponRepository(db)
+	coupon := newRepositoryTestCoupon(0)
+	_ = repo.Create(coupon)
+	_ = repo.Use(coupon, &domain.CouponUsage{MemberNumber: "M12345", OrderID: 1, DiscountAmount: 100, UsedAt: time.Now()})
+
+	staleCoupon, _ := repo.GetById(coupon.ID)
+	staleCoupon.UsedCount = 0
+	staleCoupon.Name = "가을 할인"
+
+	// When
+	err := repo.Update(staleCoupon)
+
+	// Then
+	assert.NoError(t, err)
+	savedCoupon, _ := repo.GetById(coupon.ID)
+	assert.Equal(t, "가을 할인", savedCoupon.Name)
+	assert.Equal(t, 1, savedCoupon.UsedCount)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"gorm.io/gorm"
+)
+
+type IdempotencyKeyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyKeyRepository(db *gorm.DB) *IdempotencyKeyRepositoryImpl {
+	return &IdempotencyKeyRepositoryImpl{db: db}
+}
+
+func (r *IdempotencyKeyRepositoryImpl) Create(key *domain.IdempotencyKey) error {
+	return r.db.Create(key).Error
+}
+
+func (r *IdempotencyKeyRepositoryImpl) GetByKey(memberNumber string, key string) (*domain.IdempotencyKey, error) {
+	var idempotencyKey domain.IdempotencyKey
+	if err := r.db.First(&idempotencyKey, "member_number = ? AND idempotency_key = ?", memberNumber, key).Error; err != nil {
+		return nil, err
+	}
+	return &idempotencyKey, nil
+}
+
+func (r *IdempotencyKeyRepositoryImpl) Update(key *domain.IdempotencyKey) error {
+	return r.db.Save(key).Error
+}
+
+func (r *IdempotencyKeyRepositoryImpl) Delete(id int) error {
+	return r.db.Delete(&domain.IdempotencyKey{}, "id = ?", id).Error
+}
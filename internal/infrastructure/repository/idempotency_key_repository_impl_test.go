@@ -0,0 +1,88 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestIdempotencyKeyRepositoryImpl_Create_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewIdempotencyKeyRepository(db)
+	key := &domain.IdempotencyKey{MemberNumber: "M12345", Key: "key-1", RequestHash: "hash-1"}
+
+	// When
+	err := repo.Create(key)
+
+	// Then
+	assert.NoError(t, err)
+	savedKey, err := repo.GetByKey("M12345", "key-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "hash-1", savedKey.RequestHash)
+	assert.False(t, savedKey.IsCompleted())
+}
+
+func TestIdempotencyKeyRepositoryImpl_Create_Fail_DuplicateKey(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewIdempotencyKeyRepository(db)
+	_ = repo.Create(&domain.IdempotencyKey{MemberNumber: "M12345", Key: "key-1", RequestHash: "hash-1"})
+
+	// When
+	err := repo.Create(&domain.IdempotencyKey{MemberNumber: "M12345", Key: "key-1", RequestHash: "hash-2"})
+
+	// Then
+	assert.Error(t, err)
+}
+
+func TestIdempotencyKeyRepositoryImpl_Create_Success_SameKeyOtherMember(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewIdempotencyKeyRepository(db)
+	_ = repo.Create(&domain.IdempotencyKey{MemberNumber: "M12345", Key: "key-1", RequestHash: "hash-1"})
+
+	// When
+	err := repo.Create(&domain.IdempotencyKey{MemberNumber: "M67890", Key: "key-1", RequestHash: "hash-1"})
+
+	// Then
+	assert.NoError(t, err)
+}
+
+func TestIdempotencyKeyRepositoryImpl_Update_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewIdempotencyKeyRepository(db)
+	key := &domain.IdempotencyKey{MemberNumber: "M12345", Key: "key-1", RequestHash: "hash-1"}
+	_ = repo.Create(key)
+	key.Complete(201, `{"message":"ok"}`)
+
+	// When
+	err := repo.Update(key)
+
+	// Then
+	assert.NoError(t, err)
+	savedKey, _ := repo.GetByKey("M12345", "key-1")
+	assert.Equal(t, 201, savedKey.ResponseStatus)
+	assert.Equal(t, `{"message":"ok"}`, savedKey.ResponseBody)
+}
+
+func TestIdempotencyKeyRepositoryImpl_Delete_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewIdempotencyKeyRepository(db)
+	key := &domain.IdempotencyKey{MemberNumber: "M12345", Key: "key-1", RequestHash: "hash-1"}
+	_ = repo.Create(key)
+
+	// When
+	err := repo.Delete(key.ID)
+
+	// Then
+	assert.NoError(t, err)
+	_, err = repo.GetByKey("M12345", "key-1")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
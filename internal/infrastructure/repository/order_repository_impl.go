@@ -1,10 +1,13 @@
 package repository
 
 import (
+	"math"
 	"time"
 
 	"github.com/HongJungWan/commerce-system/internal/domain"
+	domainRepository "github.com/HongJungWan/commerce-system/internal/domain/repository"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type OrderRepositoryImpl struct {
@@ -15,13 +18,17 @@ func NewOrderRepository(db *gorm.DB) *OrderRepositoryImpl {
 	return &OrderRepositoryImpl{db: db}
 }
 
+func (r *OrderRepositoryImpl) WithTx(tx *gorm.DB) domainRepository.OrderRepository {
+	return &OrderRepositoryImpl{db: tx}
+}
+
 func (r *OrderRepositoryImpl) Create(order *domain.Order) error {
 	return r.db.Create(order).Error
 }
 
 func (r *OrderRepositoryImpl) GetByOrderNumber(orderNumber string) (*domain.Order, error) {
 	var order domain.Order
-	if err := r.db.First(&order, "order_number = ?", orderNumber).Error; err != nil {
+	if err := r.db.Preload("Items").Preload("Payment").Preload("Cancellations").First(&order, "order_number = ?", orderNumber).Error; err != nil {
 		return nil, err
 	}
 	return &order, nil
@@ -29,7 +36,16 @@ func (r *OrderRepositoryImpl) GetByOrderNumber(orderNumber string) (*domain.Orde
 
 func (r *OrderRepositoryImpl) GetById(id int) (*domain.Order, error) {
 	var order domain.Order
-	if err := r.db.First(&order, "id = ?", id).Error; err != nil {
+	if err := r.db.Preload("Items").Preload("Payment").Preload("Cancellations").First(&order, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *OrderRepositoryImpl) GetByIdForUpdate(id int) (*domain.Order, error) {
+	var order domain.Order
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("Items").Preload("Payment").Preload("Cancellations").First(&order, "id = ?", id).Error; err != nil {
 		return nil, err
 	}
 	return &order, nil
@@ -37,39 +53,188 @@ func (r *OrderRepositoryImpl) GetById(id int) (*domain.Order, error) {
 
 func (r *OrderRepositoryImpl) GetByMemberNumber(memberNumber string) ([]*domain.Order, error) {
 	var orders []*domain.Order
-	if err := r.db.Where("member_number = ?", memberNumber).Find(&orders).Error; err != nil {
+	if err := r.db.Preload("Items").Preload("Payment").Preload("Cancellations").Where("member_number = ?", memberNumber).Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+func (r *OrderRepositoryImpl) Search(query *domain.OrderQuery) ([]*domain.Order, bool, error) {
+	db := r.db.Preload("Items").Preload("Payment").Preload("Cancellations")
+	if query.MemberNumber != "" {
+		db = db.Where("member_number = ?", query.MemberNumber)
+	}
+	if len(query.Statuses) > 0 {
+		db = db.Where("status IN ?", query.Statuses)
+	}
+	if query.From != nil {
+		db = db.Where("order_date >= ?", *query.From)
+	}
+	if query.To != nil {
+		db = db.Where("order_date < ?", *query.To)
+	}
+
+	// 주문 ID 기준 키셋 페이지네이션
+	if query.IsOldestFirst() {
+		if query.Cursor > 0 {
+			db = db.Where("id > ?", query.Cursor)
+		}
+		db = db.Order("id ASC")
+	} else {
+		if query.Cursor > 0 {
+			db = db.Where("id < ?", query.Cursor)
+		}
+		db = db.Order("id DESC")
+	}
+
+	// 다음 페이지 존재 여부를 확인하기 위해 한 건을 더 조회
+	pageSize := query.PageSize()
+	var orders []*domain.Order
+	if err := db.Limit(pageSize + 1).Find(&orders).Error; err != nil {
+		return nil, false, err
+	}
+	if len(orders) > pageSize {
+		return orders[:pageSize], true, nil
+	}
+	return orders, false, nil
+}
+
+func (r *OrderRepositoryImpl) SearchByCriteria(criteria *domain.OrderSearchCriteria) ([]*domain.Order, int64, error) {
+	db := r.db.Model(&domain.Order{})
+	if criteria.OrderNumber != "" {
+		db = db.Where("order_number = ?", criteria.OrderNumber)
+	}
+	if criteria.MemberNumber != "" {
+		db = db.Where("member_number = ?", criteria.MemberNumber)
+	}
+	if criteria.ProductNumber != "" {
+		db = db.Where("id IN (?)", r.db.Model(&domain.OrderItem{}).Select("order_id").Where("product_number = ?", criteria.ProductNumber))
+	}
+	if len(criteria.Statuses) > 0 {
+		db = db.Where("status IN ?", criteria.Statuses)
+	}
+	if criteria.From != nil {
+		db = db.Where("order_date >= ?", *criteria.From)
+	}
+	if criteria.To != nil {
+		db = db.Where("order_date < ?", *criteria.To)
+	}
+
+	var totalCount int64
+	if err := db.Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	switch criteria.Sort {
+	case domain.OrderSearchSortOldest:
+		db = db.Order("order_date ASC").Order("id ASC")
+	case domain.OrderSearchSortAmountDesc:
+		db = db.Order("total_amount DESC").Order("id DESC")
+	case domain.OrderSearchSortAmountAsc:
+		db = db.Order("total_amount ASC").Order("id ASC")
+	default:
+		db = db.Order("order_date DESC").Order("id DESC")
+	}
+
+	var orders []*domain.Order
+	if err := db.Preload("Items").Preload("Payment").Preload("Cancellations").
+		Offset(criteria.Offset()).Limit(criteria.PageSize()).Find(&orders).Error; err != nil {
+		return nil, 0, err
+	}
+	return orders, totalCount, nil
+}
+
+func (r *OrderRepositoryImpl) GetByStatus(statuses ...domain.OrderStatus) ([]*domain.Order, error) {
+	var orders []*domain.Order
+	if err := r.db.Preload("Items").Preload("Payment").Preload("Cancellations").Where("status IN ?", statuses).Order("order_date ASC").Find(&orders).Error; err != nil {
 		return nil, err
 	}
 	return orders, nil
 }
 
 func (r *OrderRepositoryImpl) Update(order *domain.Order) error {
-	return r.db.Save(order).Error
+	return r.db.Session(&gorm.Session{FullSaveAssociations: true}).Save(order).Error
 }
 
-func (r *OrderRepositoryImpl) GetMonthlyStats(month string) (int64, int64, error) {
+// CompletePaymentRefund 는 대행사에 반영된 환불 금액을 대기 금액에서 차감합니다.
+func (r *OrderRepositoryImpl) CompletePaymentRefund(paymentID int, amount int64) error {
+	return r.db.Model(&domain.Payment{}).
+		Where("id = ?", paymentID).
+		UpdateColumn("pending_refund", gorm.Expr("pending_refund - ?", amount)).Error
+}
+
+func (r *OrderRepositoryImpl) GetMonthlyStats(month string) (*domain.OrderStats, error) {
 	startDate, err := time.Parse("2006-01", month)
 	if err != nil {
-		return 0, 0, err
+		return nil, err
 	}
 	endDate := startDate.AddDate(0, 1, 0)
 
-	var totalSales int64
-	var totalCanceled int64
+	var stats domain.OrderStats
 
-	// 매출액 계산 (취소되지 않은 주문)
+	// 취소액 계산 (전체 취소 주문과 부분 취소 금액)
 	if err := r.db.Model(&domain.Order{}).
-		Where("order_date >= ? AND order_date < ? AND is_canceled = ?", startDate, endDate, false).
-		Select("COALESCE(SUM(total_amount), 0)").Scan(&totalSales).Error; err != nil {
-		return 0, 0, err
+		Where("order_date >= ? AND order_date < ?", startDate, endDate).
+		Select("COALESCE(SUM(CASE WHEN status = ? THEN total_amount ELSE canceled_amount END), 0)", domain.OrderStatusCanceled).
+		Scan(&stats.TotalCanceled).Error; err != nil {
+		return nil, err
 	}
 
-	// 취소액 계산 (취소된 주문)
+	// 취소 사유별 전체 취소 주문 수와 금액 집계
 	if err := r.db.Model(&domain.Order{}).
-		Where("order_date >= ? AND order_date < ? AND is_canceled = ?", startDate, endDate, true).
-		Select("COALESCE(SUM(total_amount), 0)").Scan(&totalCanceled).Error; err != nil {
-		return 0, 0, err
+		Where("order_date >= ? AND order_date < ? AND status = ?", startDate, endDate, domain.OrderStatusCanceled).
+		Select("cancel_reason AS reason, COUNT(*) AS count, COALESCE(SUM(total_amount), 0) AS amount").
+		Group("cancel_reason").Order("cancel_reason").
+		Scan(&stats.CancelReasons).Error; err != nil {
+		return nil, err
+	}
+
+	// 매출 집계 (취소되지 않은 수량 기준, 상품별 할인과 세금은 수량 비율로 나누어 반영)
+	activeShare := func(amount string) string {
+		return "COALESCE(SUM(" + amount + " * (quantity - canceled_quantity) / quantity), 0)"
+	}
+	var sales struct {
+		Merchandise float64
+		Discount    float64
+		Tax         float64
+		AddedTax    float64
+	}
+	activeOrders := r.db.Model(&domain.Order{}).Select("id").
+		Where("order_date >= ? AND order_date < ? AND status <> ?", startDate, endDate, domain.OrderStatusCanceled)
+	if err := r.db.Model(&domain.OrderItem{}).
+		Where("order_id IN (?)", activeOrders).
+		Select(activeShare("line_total") + " AS merchandise, " +
+			activeShare("discount_amount") + " AS discount, " +
+			activeShare("tax_amount") + " AS tax, " +
+			activeShare("CASE WHEN tax_inclusive THEN 0 ELSE tax_amount END") + " AS added_tax").
+		Scan(&sales).Error; err != nil {
+		return nil, err
+	}
+
+	// 배송비 집계
+	var shippingFee int64
+	if err := r.db.Model(&domain.Order{}).
+		Where("order_date >= ? AND order_date < ? AND status <> ?", startDate, endDate, domain.OrderStatusCanceled).
+		Select("COALESCE(SUM(shipping_fee), 0)").
+		Scan(&shippingFee).Error; err != nil {
+		return nil, err
+	}
+
+	// 매출액은 할인 후 상품 금액, 순매출액은 여기서 가격에 포함된 세금까지 뺀 금액이며 배송비는 총매출액에만 포함
+	merchandise := int64(math.Round(sales.Merchandise))
+	discount := int64(math.Round(sales.Discount))
+	addedTax := int64(math.Round(sales.AddedTax))
+	stats.TotalTax = int64(math.Round(sales.Tax))
+	stats.TotalSales = merchandise - discount
+	stats.GrossSales = stats.TotalSales + addedTax + shippingFee
+	stats.NetSales = stats.TotalSales + addedTax - stats.TotalTax
+
+	// 반품 환불액 계산 (해당 월에 환불 완료된 반품)
+	if err := r.db.Model(&domain.ReturnRequest{}).
+		Where("refunded_at >= ? AND refunded_at < ? AND status = ?", startDate, endDate, domain.ReturnStatusRefunded).
+		Select("COALESCE(SUM(amount), 0)").Scan(&stats.TotalRefunded).Error; err != nil {
+		return nil, err
 	}
 
-	return totalSales, totalCanceled, nil
+	return &stats, nil
 }
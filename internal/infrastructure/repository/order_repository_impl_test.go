@@ -1,6 +1,7 @@
 package repository_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -15,14 +16,12 @@ func TestOrderRepositoryImpl_Create_Success(t *testing.T) {
 	db := fixtures.SetupTestDB()
 	repo := repository.NewOrderRepository(db)
 	order := &domain.Order{
-		OrderNumber:   "O12345",
-		OrderDate:     time.Now(),
-		MemberNumber:  "M12345",
-		ProductNumber: "P12345",
-		Price:         1000,
-		Quantity:      2,
-		TotalAmount:   2000,
-		IsCanceled:    true,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusCanceled,
 	}
 
 	// When
@@ -37,24 +36,20 @@ func TestOrderRepositoryImpl_Create_Failure_DuplicateOrderNumber(t *testing.T) {
 	db := fixtures.SetupTestDB()
 	repo := repository.NewOrderRepository(db)
 	order1 := &domain.Order{
-		OrderNumber:   "O12345",
-		OrderDate:     time.Now(),
-		MemberNumber:  "M12345",
-		ProductNumber: "P12345",
-		Price:         1000,
-		Quantity:      2,
-		TotalAmount:   2000,
-		IsCanceled:    true,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusCanceled,
 	}
 	order2 := &domain.Order{
-		OrderNumber:   "O12345",
-		OrderDate:     time.Now(),
-		MemberNumber:  "M12346",
-		ProductNumber: "P12346",
-		Price:         2000,
-		Quantity:      1,
-		TotalAmount:   2000,
-		IsCanceled:    true,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12346",
+		Items:        []domain.OrderItem{{ProductNumber: "P12346", Price: 2000, Quantity: 1, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusCanceled,
 	}
 	_ = repo.Create(order1)
 
@@ -70,14 +65,12 @@ func TestOrderRepositoryImpl_GetByOrderNumber_Success(t *testing.T) {
 	db := fixtures.SetupTestDB()
 	repo := repository.NewOrderRepository(db)
 	order := &domain.Order{
-		OrderNumber:   "O12345",
-		OrderDate:     time.Now(),
-		MemberNumber:  "M12345",
-		ProductNumber: "P12345",
-		Price:         1000,
-		Quantity:      2,
-		TotalAmount:   2000,
-		IsCanceled:    true,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusCanceled,
 	}
 	_ = repo.Create(order)
 
@@ -87,6 +80,8 @@ func TestOrderRepositoryImpl_GetByOrderNumber_Success(t *testing.T) {
 	// Then
 	assert.NoError(t, err)
 	assert.Equal(t, order.MemberNumber, retrievedOrder.MemberNumber)
+	assert.Len(t, retrievedOrder.Items, 1)
+	assert.Equal(t, "P12345", retrievedOrder.Items[0].ProductNumber)
 }
 
 func TestOrderRepositoryImpl_GetByOrderNumber_Failure_NotFound(t *testing.T) {
@@ -107,24 +102,20 @@ func TestOrderRepositoryImpl_GetByMemberNumber_Success(t *testing.T) {
 	db := fixtures.SetupTestDB()
 	repo := repository.NewOrderRepository(db)
 	order1 := &domain.Order{
-		OrderNumber:   "O12345",
-		OrderDate:     time.Now(),
-		MemberNumber:  "M12345",
-		ProductNumber: "P12345",
-		Price:         1000,
-		Quantity:      2,
-		TotalAmount:   2000,
-		IsCanceled:    true,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusCanceled,
 	}
 	order2 := &domain.Order{
-		OrderNumber:   "O12346",
-		OrderDate:     time.Now(),
-		MemberNumber:  "M12345",
-		ProductNumber: "P12346",
-		Price:         1500,
-		Quantity:      1,
-		TotalAmount:   1500,
-		IsCanceled:    true,
+		OrderNumber:  "O12346",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12346", Price: 1500, Quantity: 1, LineTotal: 1500}},
+		TotalAmount:  1500,
+		Status:       domain.OrderStatusCanceled,
 	}
 	_ = repo.Create(order1)
 	_ = repo.Create(order2)
@@ -137,24 +128,96 @@ func TestOrderRepositoryImpl_GetByMemberNumber_Success(t *testing.T) {
 	assert.Len(t, orders, 2)
 }
 
+func TestOrderRepositoryImpl_Search_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewOrderRepository(db)
+	statuses := []domain.OrderStatus{domain.OrderStatusPaid, domain.OrderStatusCanceled, domain.OrderStatusPaid, domain.OrderStatusPaid}
+	for i, status := range statuses {
+		_ = repo.Create(&domain.Order{
+			OrderNumber:  fmt.Sprintf("O1234%d", i),
+			OrderDate:    time.Date(2024, 9, 10+i, 0, 0, 0, 0, time.UTC),
+			MemberNumber: "M12345",
+			Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 1, LineTotal: 1000}},
+			TotalAmount:  1000,
+			Status:       status,
+		})
+	}
+	from := time.Date(2024, 9, 11, 0, 0, 0, 0, time.UTC)
+
+	// When
+	orders, hasNext, err := repo.Search(&domain.OrderQuery{
+		MemberNumber: "M12345",
+		Statuses:     []domain.OrderStatus{domain.OrderStatusPaid},
+		From:         &from,
+		Sort:         domain.OrderSortOldest,
+		Limit:        1,
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, hasNext)
+	assert.Len(t, orders, 1)
+	assert.Equal(t, "O12342", orders[0].OrderNumber)
+	assert.Len(t, orders[0].Items, 1)
+}
+
+func TestOrderRepositoryImpl_SearchByCriteria_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewOrderRepository(db)
+	amounts := []int64{3000, 1000, 2000}
+	for i, amount := range amounts {
+		_ = repo.Create(&domain.Order{
+			OrderNumber:  fmt.Sprintf("O1234%d", i),
+			OrderDate:    time.Now(),
+			MemberNumber: fmt.Sprintf("M1234%d", i),
+			Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: amount, Quantity: 1, LineTotal: amount}},
+			TotalAmount:  amount,
+			Status:       domain.OrderStatusPaid,
+		})
+	}
+	_ = repo.Create(&domain.Order{
+		OrderNumber:  "O99999",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P99999", Price: 5000, Quantity: 1, LineTotal: 5000}},
+		TotalAmount:  5000,
+		Status:       domain.OrderStatusPaid,
+	})
+
+	// When
+	orders, totalCount, err := repo.SearchByCriteria(&domain.OrderSearchCriteria{
+		ProductNumber: "P12345",
+		Sort:          domain.OrderSearchSortAmountDesc,
+		Page:          2,
+		Size:          2,
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, totalCount)
+	assert.Len(t, orders, 1)
+	assert.Equal(t, "O12341", orders[0].OrderNumber)
+	assert.Len(t, orders[0].Items, 1)
+}
+
 func TestOrderRepositoryImpl_Update_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	repo := repository.NewOrderRepository(db)
 	order := &domain.Order{
-		OrderNumber:   "O12345",
-		OrderDate:     time.Now(),
-		MemberNumber:  "M12345",
-		ProductNumber: "P12345",
-		Price:         1000,
-		Quantity:      2,
-		TotalAmount:   2000,
-		IsCanceled:    true,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPending,
 	}
 	_ = repo.Create(order)
 
 	// When
-	order.IsCanceled = true
+	order.Status = domain.OrderStatusPaid
 	err := repo.Update(order)
 
 	// Then
@@ -162,7 +225,7 @@ func TestOrderRepositoryImpl_Update_Success(t *testing.T) {
 
 	// Verify
 	updatedOrder, _ := repo.GetByOrderNumber("O12345")
-	assert.Equal(t, true, updatedOrder.IsCanceled)
+	assert.Equal(t, domain.OrderStatusPaid, updatedOrder.Status)
 }
 
 func TestOrderRepositoryImpl_GetMonthlyStats_Success(t *testing.T) {
@@ -170,37 +233,155 @@ func TestOrderRepositoryImpl_GetMonthlyStats_Success(t *testing.T) {
 	db := fixtures.SetupTestDB()
 	repo := repository.NewOrderRepository(db)
 	order1 := &domain.Order{
-		ID:            12345,
-		OrderNumber:   "O12345",
-		OrderDate:     time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC),
-		MemberNumber:  "M12345",
-		ProductNumber: "P12345",
-		Price:         1000,
-		Quantity:      2,
-		TotalAmount:   2000,
-		IsCanceled:    false,
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPending,
 	}
 	order2 := &domain.Order{
-		ID:            12346,
-		OrderNumber:   "O12346",
-		OrderDate:     time.Date(2024, 9, 15, 0, 0, 0, 0, time.UTC),
-		MemberNumber:  "M12346",
-		ProductNumber: "P12346",
-		Price:         1500,
-		Quantity:      1,
-		TotalAmount:   1500,
-		IsCanceled:    true,
+		ID:           12346,
+		OrderNumber:  "O12346",
+		OrderDate:    time.Date(2024, 9, 15, 0, 0, 0, 0, time.UTC),
+		MemberNumber: "M12346",
+		Items:        []domain.OrderItem{{ProductNumber: "P12346", Price: 1500, Quantity: 1, LineTotal: 1500}},
+		TotalAmount:  1500,
+		Status:       domain.OrderStatusCanceled,
 	}
 	_ = repo.Create(order1)
 	_ = repo.Create(order2)
 
 	// When
-	totalSales, totalCanceled, err := repo.GetMonthlyStats("2024-09")
+	stats, err := repo.GetMonthlyStats("2024-09")
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2000, stats.TotalSales)
+	assert.EqualValues(t, 1500, stats.TotalCanceled)
+}
+
+func TestOrderRepositoryImpl_GetMonthlyStats_Success_PartialCancellation(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewOrderRepository(db)
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 3, LineTotal: 3000}},
+		TotalAmount:  3000,
+		Status:       domain.OrderStatusPaid,
+	}
+	_ = repo.Create(order)
+	_, _ = order.PartialCancel("P12345", 1)
+	_ = repo.Update(order)
+
+	// When
+	stats, err := repo.GetMonthlyStats("2024-09")
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2000, stats.TotalSales)
+	assert.EqualValues(t, 1000, stats.TotalCanceled)
+
+	savedOrder, _ := repo.GetById(12345)
+	assert.Len(t, savedOrder.Cancellations, 1)
+}
+
+func TestOrderRepositoryImpl_GetMonthlyStats_Success_Tax(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewOrderRepository(db)
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC),
+		MemberNumber: "M12345",
+		Items: []domain.OrderItem{
+			{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000, TaxAmount: 200},
+			{ProductNumber: "P12346", Price: 1080, Quantity: 1, LineTotal: 1080, TaxAmount: 80, TaxInclusive: true},
+		},
+		TotalAmount: 3080,
+		TaxAmount:   280,
+		IncludedTax: 80,
+		ShippingFee: 3000,
+		Status:      domain.OrderStatusPaid,
+	}
+	_ = repo.Create(order)
+	_, _ = order.PartialCancel("P12345", 1)
+	_ = repo.Update(order)
+
+	// When
+	stats, err := repo.GetMonthlyStats("2024-09")
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 5180, stats.GrossSales)
+	assert.EqualValues(t, 180, stats.TotalTax)
+	assert.EqualValues(t, 2000, stats.NetSales)
+}
+
+func TestOrderRepositoryImpl_GetMonthlyStats_Success_DiscountAndShipping(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewOrderRepository(db)
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC),
+		MemberNumber: "M12345",
+		Items: []domain.OrderItem{
+			{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000, DiscountAmount: 200, TaxAmount: 180},
+			{ProductNumber: "P12346", Price: 1100, Quantity: 1, LineTotal: 1100, DiscountAmount: 100, TaxAmount: 100, TaxInclusive: true},
+		},
+		TotalAmount:    3100,
+		CouponCode:     "SAVE10",
+		DiscountAmount: 300,
+		TaxAmount:      280,
+		IncludedTax:    100,
+		ShippingFee:    3000,
+		Status:         domain.OrderStatusPaid,
+	}
+	_ = repo.Create(order)
+
+	// When
+	stats, err := repo.GetMonthlyStats("2024-09")
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2800, stats.TotalSales)
+	assert.EqualValues(t, 280, stats.TotalTax)
+	assert.EqualValues(t, 2700, stats.NetSales)
+	assert.EqualValues(t, order.PaymentAmount(), stats.GrossSales)
+}
+
+func TestOrderRepositoryImpl_GetMonthlyStats_Success_Refunded(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewOrderRepository(db)
+	returnRepo := repository.NewReturnRequestRepository(db)
+	refundedAt := time.Date(2024, 9, 20, 0, 0, 0, 0, time.UTC)
+	_ = returnRepo.Create(&domain.ReturnRequest{
+		OrderID:       12345,
+		MemberNumber:  "M12345",
+		ProductNumber: "P12345",
+		Quantity:      1,
+		Amount:        1000,
+		Reason:        "파손",
+		Status:        domain.ReturnStatusRefunded,
+		RequestedAt:   refundedAt,
+		RefundedAt:    &refundedAt,
+	})
+
+	// When
+	stats, err := repo.GetMonthlyStats("2024-09")
 
 	// Then
 	assert.NoError(t, err)
-	assert.EqualValues(t, 2000, totalSales)
-	assert.EqualValues(t, 1500, totalCanceled)
+	assert.EqualValues(t, 1000, stats.TotalRefunded)
 }
 
 func TestOrderRepositoryImpl_GetMonthlyStats_Failure_InvalidMonth(t *testing.T) {
@@ -209,10 +390,9 @@ func TestOrderRepositoryImpl_GetMonthlyStats_Failure_InvalidMonth(t *testing.T)
 	repo := repository.NewOrderRepository(db)
 
 	// When
-	totalSales, totalCanceled, err := repo.GetMonthlyStats("invalid-month")
+	stats, err := repo.GetMonthlyStats("invalid-month")
 
 	// Then
 	assert.Error(t, err)
-	assert.EqualValues(t, 0, totalSales)
-	assert.EqualValues(t, 0, totalCanceled)
+	assert.Nil(t, stats)
 }
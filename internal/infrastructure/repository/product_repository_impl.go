@@ -1,8 +1,12 @@
 package repository
 
 import (
+	"strings"
+
 	"github.com/HongJungWan/commerce-system/internal/domain"
+	domainRepository "github.com/HongJungWan/commerce-system/internal/domain/repository"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ProductRepositoryImpl struct {
@@ -13,25 +17,120 @@ func NewProductRepository(db *gorm.DB) *ProductRepositoryImpl {
 	return &ProductRepositoryImpl{db: db}
 }
 
+func (r *ProductRepositoryImpl) WithTx(tx *gorm.DB) domainRepository.ProductRepository {
+	return &ProductRepositoryImpl{db: tx}
+}
+
 func (r *ProductRepositoryImpl) Create(product *domain.Product) error {
 	return r.db.Create(product).Error
 }
 
-func (r *ProductRepositoryImpl) GetAll(filter map[string]interface{}) ([]*domain.Product, error) {
+// Search 는 조회 조건에 맞는 상품을 한 페이지 조회하고, 다음 페이지가 있으면 그 시작 위치를 함께 반환합니다.
+func (r *ProductRepositoryImpl) Search(query *domain.ProductQuery) ([]*domain.Product, *domain.ProductCursor, error) {
+	db := r.filter(query)
+	relevance, relevanceArgs := productRelevance(query.Keyword)
+
+	// 정렬 기준 값과 상품 ID 기준 키셋 페이지네이션
+	cursor := query.Cursor
+	switch query.Sort {
+	case domain.ProductSortPriceAsc:
+		if cursor != nil {
+			db = db.Where("price > ? OR (price = ? AND id > ?)", cursor.Key, cursor.Key, cursor.ID)
+		}
+		db = db.Order("price ASC").Order("id ASC")
+	case domain.ProductSortPriceDesc:
+		if cursor != nil {
+			db = db.Where("price < ? OR (price = ? AND id < ?)", cursor.Key, cursor.Key, cursor.ID)
+		}
+		db = db.Order("price DESC").Order("id DESC")
+	case domain.ProductSortRelevance:
+		if cursor != nil {
+			args := append([]interface{}{}, relevanceArgs...)
+			args = append(args, cursor.Key)
+			args = append(args, relevanceArgs...)
+			args = append(args, cursor.Key, cursor.ID)
+			db = db.Where("("+relevance+") < ? OR (("+relevance+") = ? AND id < ?)", args...)
+		}
+		db = db.Order(clause.OrderBy{Expression: clause.Expr{SQL: "(" + relevance + ") DESC, id DESC", Vars: relevanceArgs}})
+	default:
+		if cursor != nil {
+			db = db.Where("id < ?", cursor.ID)
+		}
+		db = db.Order("id DESC")
+	}
+
+	// 다음 페이지 존재 여부를 확인하기 위해 한 건을 더 조회
+	pageSize := query.PageSize()
 	var products []*domain.Product
-	query := r.db.Model(&domain.Product{})
+	if err := db.Limit(pageSize + 1).Find(&products).Error; err != nil {
+		return nil, nil, err
+	}
+	if len(products) <= pageSize {
+		return products, nil, nil
+	}
 
-	if category, ok := filter["category"]; ok {
-		query = query.Where("category = ?", category)
+	products = products[:pageSize]
+	last := products[pageSize-1]
+	next := &domain.ProductCursor{Sort: query.Sort, ID: last.ID}
+	switch query.Sort {
+	case domain.ProductSortPriceAsc, domain.ProductSortPriceDesc:
+		next.Key = last.Price
+	case domain.ProductSortRelevance:
+		if err := r.db.Model(&domain.Product{}).Select(relevance, relevanceArgs...).Where("id = ?", last.ID).Scan(&next.Key).Error; err != nil {
+			return nil, nil, err
+		}
+	default:
+		next.Key = int64(last.ID)
 	}
-	if productName, ok := filter["product_name"]; ok {
-		query = query.Where("product_name LIKE ?", productName.(string)+"%")
+	return products, next, nil
+}
+
+// Count 는 페이지와 관계없이 조회 조건에 맞는 전체 상품 수를 반환합니다.
+func (r *ProductRepositoryImpl) Count(query *domain.ProductQuery) (int64, error) {
+	var count int64
+	if err := r.filter(query).Count(&count).Error; err != nil {
+		return 0, err
 	}
+	return count, nil
+}
 
-	if err := query.Find(&products).Error; err != nil {
-		return nil, err
+func (r *ProductRepositoryImpl) filter(query *domain.ProductQuery) *gorm.DB {
+	// 보관 처리된 상품은 목록에서 제외하고, 옵션 상품은 GetVariants로 상위 상품 아래에 묶어서 조회
+	db := r.db.Model(&domain.Product{}).Where("archived_at IS NULL AND parent_product_number = ''")
+
+	if len(query.Categories) > 0 {
+		db = db.Where("category IN ?", query.Categories)
+	}
+	if query.Keyword != "" {
+		pattern := "%" + escapeLike(query.Keyword) + "%"
+		db = db.Where("(product_name LIKE ? ESCAPE '!' OR description LIKE ? ESCAPE '!')", pattern, pattern)
+	}
+	if query.MinPrice != nil {
+		db = db.Where("price >= ?", *query.MinPrice)
+	}
+	if query.MaxPrice != nil {
+		db = db.Where("price <= ?", *query.MaxPrice)
+	}
+	if query.InStock {
+		// 옵션이 있는 상품은 재고를 옵션 상품별로 관리하므로 판매 중인 옵션 상품의 재고로 판단
+		variantsInStock := r.db.Model(&domain.Product{}).Select("parent_product_number").
+			Where("parent_product_number <> '' AND archived_at IS NULL AND stock_quantity > 0")
+		db = db.Where("(stock_quantity > 0 OR product_number IN (?))", variantsInStock)
 	}
-	return products, nil
+	return db
+}
+
+// productRelevance 는 검색어 관련도 점수 SQL 식과 인자를 반환합니다.
+// 상품명 일치 3점, 상품명 시작 2점, 상품명 포함 1점, 설명에만 포함 0점입니다.
+func productRelevance(keyword string) (string, []interface{}) {
+	escaped := escapeLike(keyword)
+	return "CASE WHEN product_name LIKE ? ESCAPE '!' THEN 3 WHEN product_name LIKE ? ESCAPE '!' THEN 2 WHEN product_name LIKE ? ESCAPE '!' THEN 1 ELSE 0 END",
+		[]interface{}{escaped, escaped + "%", "%" + escaped + "%"}
+}
+
+// escapeLike 는 검색어의 LIKE 와일드카드 문자를 일반 문자로 검색하도록 이스케이프합니다.
+func escapeLike(value string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(value)
 }
 
 func (r *ProductRepositoryImpl) GetById(id int) (*domain.Product, error) {
@@ -39,6 +138,9 @@ func (r *ProductRepositoryImpl) GetById(id int) (*domain.Product, error) {
 	if err := r.db.First(&product, "id = ?", id).Error; err != nil {
 		return nil, err
 	}
+	if err := r.resolveVariantPrices(&product); err != nil {
+		return nil, err
+	}
 	return &product, nil
 }
 
@@ -47,11 +149,135 @@ func (r *ProductRepositoryImpl) GetByProductNumber(productNumber string) (*domai
 	if err := r.db.First(&product, "product_number = ?", productNumber).Error; err != nil {
 		return nil, err
 	}
+	if err := r.resolveVariantPrices(&product); err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+func (r *ProductRepositoryImpl) GetBySKU(sku string) (*domain.Product, error) {
+	var product domain.Product
+	if err := r.db.First(&product, "sku = ?", sku).Error; err != nil {
+		return nil, err
+	}
+	if err := r.resolveVariantPrices(&product); err != nil {
+		return nil, err
+	}
 	return &product, nil
 }
 
+// GetVariants 는 보관 처리된 옵션 상품을 포함해 상위 상품별 옵션 상품을 조회합니다.
+func (r *ProductRepositoryImpl) GetVariants(parentProductNumbers ...string) ([]*domain.Product, error) {
+	var variants []*domain.Product
+	if len(parentProductNumbers) == 0 {
+		return variants, nil
+	}
+	if err := r.db.Where("parent_product_number IN ?", parentProductNumbers).Order("id ASC").Find(&variants).Error; err != nil {
+		return nil, err
+	}
+	if err := r.resolveVariantPrices(variants...); err != nil {
+		return nil, err
+	}
+	return variants, nil
+}
+
+// resolveVariantPrices 는 상위 상품 가격을 따르는 옵션 상품에 상위 상품의 현재 가격을 채웁니다.
+func (r *ProductRepositoryImpl) resolveVariantPrices(products ...*domain.Product) error {
+	var parentProductNumbers []string
+	for _, product := range products {
+		if product.InheritsPrice() {
+			parentProductNumbers = append(parentProductNumbers, product.ParentProductNumber)
+		}
+	}
+	if len(parentProductNumbers) == 0 {
+		return nil
+	}
+
+	var parents []*domain.Product
+	if err := r.db.Where("product_number IN ?", parentProductNumbers).Find(&parents).Error; err != nil {
+		return err
+	}
+	for _, product := range products {
+		for _, parent := range parents {
+			product.ResolvePrice(parent)
+		}
+	}
+	return nil
+}
+
+// Update 는 재고수량을 제외한 상품 정보를 저장합니다. 재고는 ApplyStockMovement로만 변경합니다.
+// 상위 상품 가격을 따르는 옵션 상품의 저장된 가격도 함께 맞춰, 저장된 가격이 상위 상품과 다르면 전용 가격으로 볼 수 있도록 합니다.
 func (r *ProductRepositoryImpl) Update(product *domain.Product) error {
-	return r.db.Save(product).Error
+	if err := r.db.Omit("stock_quantity").Save(product).Error; err != nil {
+		return err
+	}
+	if !product.HasVariants() {
+		return nil
+	}
+	return r.db.Model(&domain.Product{}).
+		Where("parent_product_number = ? AND price_override IS NULL", product.ProductNumber).
+		UpdateColumn("price", product.Price).Error
+}
+
+func (r *ProductRepositoryImpl) ApplyStockMovement(movement *domain.StockMovement) error {
+	return r.applyStockMovement(movement, nil)
+}
+
+func (r *ProductRepositoryImpl) ApplyStockMovementIfCurrent(movement *domain.StockMovement, expectedQuantity int) error {
+	return r.applyStockMovement(movement, &expectedQuantity)
+}
+
+func (r *ProductRepositoryImpl) applyStockMovement(movement *domain.StockMovement, expectedQuantity *int) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// 재고가 음수가 되지 않는 경우에만 반영되도록 조건부 UPDATE로 처리
+		query := tx.Model(&domain.Product{}).
+			Where("product_number = ? AND stock_quantity + ? >= 0", movement.ProductNumber, movement.Delta)
+		if expectedQuantity != nil {
+			query = query.Where("stock_quantity = ?", *expectedQuantity)
+		}
+		result := query.UpdateColumn("stock_quantity", gorm.Expr("stock_quantity + ?", movement.Delta))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			product, err := r.WithTx(tx).GetByProductNumber(movement.ProductNumber)
+			if err != nil {
+				return err
+			}
+			if expectedQuantity != nil && product.StockQuantity != *expectedQuantity {
+				return domain.ErrStockConflict
+			}
+			return domain.ErrInsufficientStock
+		}
+
+		// 같은 트랜잭션에서 변경 후 재고를 조회해 이력에 기록
+		var product domain.Product
+		if err := tx.Select("stock_quantity").First(&product, "product_number = ?", movement.ProductNumber).Error; err != nil {
+			return err
+		}
+		movement.Balance = product.StockQuantity
+		return tx.Create(movement).Error
+	})
+}
+
+func (r *ProductRepositoryImpl) GetStockMovements(productNumber string) ([]*domain.StockMovement, error) {
+	var movements []*domain.StockMovement
+	if err := r.db.Where("product_number = ?", productNumber).Order("id ASC").Find(&movements).Error; err != nil {
+		return nil, err
+	}
+	return movements, nil
+}
+
+func (r *ProductRepositoryImpl) CreatePriceChange(change *domain.ProductPriceChange) error {
+	return r.db.Create(change).Error
+}
+
+func (r *ProductRepositoryImpl) GetPriceChanges(productNumber string) ([]*domain.ProductPriceChange, error) {
+	var changes []*domain.ProductPriceChange
+	if err := r.db.Where("product_number = ?", productNumber).Order("id ASC").Find(&changes).Error; err != nil {
+		return nil, err
+	}
+	return changes, nil
 }
 
 func (r *ProductRepositoryImpl) Delete(id int) error {
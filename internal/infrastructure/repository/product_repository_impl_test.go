@@ -1,12 +1,15 @@
 package repository_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/HongJungWan/commerce-system/internal/domain"
 	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
 	"github.com/HongJungWan/commerce-system/test/fixtures"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 )
 
 func TestProductRepositoryImpl_Create_Success(t *testing.T) {
@@ -55,7 +58,7 @@ func TestProductRepositoryImpl_Create_Failure_DuplicateProductNumber(t *testing.
 	assert.Error(t, err)
 }
 
-func TestProductRepositoryImpl_GetAll_Success(t *testing.T) {
+func TestProductRepositoryImpl_Search_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	repo := repository.NewProductRepository(db)
@@ -77,14 +80,14 @@ func TestProductRepositoryImpl_GetAll_Success(t *testing.T) {
 	_ = repo.Create(product2)
 
 	// When
-	products, err := repo.GetAll(map[string]interface{}{})
+	products, _, err := repo.Search(&domain.ProductQuery{})
 
 	// Then
 	assert.NoError(t, err)
 	assert.Len(t, products, 2)
 }
 
-func TestProductRepositoryImpl_GetAll_WithFilters(t *testing.T) {
+func TestProductRepositoryImpl_Search_WithFilters(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	repo := repository.NewProductRepository(db)
@@ -106,9 +109,9 @@ func TestProductRepositoryImpl_GetAll_WithFilters(t *testing.T) {
 	_ = repo.Create(product2)
 
 	// When
-	products, err := repo.GetAll(map[string]interface{}{
-		"category": "Electronics",
-		"name":     "Smart",
+	products, _, err := repo.Search(&domain.ProductQuery{
+		Categories: []string{"Electronics"},
+		Keyword:    "Smart",
 	})
 
 	// Then
@@ -117,6 +120,217 @@ func TestProductRepositoryImpl_GetAll_WithFilters(t *testing.T) {
 	assert.Equal(t, "Smartphone", products[0].ProductName)
 }
 
+func TestProductRepositoryImpl_Search_ExcludesArchived(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	archivedAt := time.Now()
+	product1 := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Product One",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	product2 := &domain.Product{
+		ProductNumber: "P12346",
+		ProductName:   "Product Two",
+		Price:         1500,
+		StockQuantity: 5,
+		ArchivedAt:    &archivedAt,
+	}
+	_ = repo.Create(product1)
+	_ = repo.Create(product2)
+
+	// When
+	products, _, err := repo.Search(&domain.ProductQuery{})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, products, 1)
+	assert.Equal(t, "P12345", products[0].ProductNumber)
+
+	archivedProduct, err := repo.GetByProductNumber("P12346")
+	assert.NoError(t, err)
+	assert.True(t, archivedProduct.IsArchived())
+}
+
+func TestProductRepositoryImpl_Search_KeywordMatchesNameAndDescription(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	_ = repo.Create(&domain.Product{ProductNumber: "P1", ProductName: "Cheese Pizza", Price: 1000})
+	_ = repo.Create(&domain.Product{ProductNumber: "P2", ProductName: "Garlic Bread", Description: "피자와 어울리는 cheese 빵", Price: 1000})
+	_ = repo.Create(&domain.Product{ProductNumber: "P3", ProductName: "Cola", Price: 1000})
+	_ = repo.Create(&domain.Product{ProductNumber: "P4", ProductName: "100% Juice", Price: 1000})
+
+	// When
+	products, _, err := repo.Search(&domain.ProductQuery{Keyword: "CHEESE", Sort: domain.ProductSortNewest})
+	percentProducts, _, _ := repo.Search(&domain.ProductQuery{Keyword: "0%", Sort: domain.ProductSortNewest})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, products, 2)
+	assert.Len(t, percentProducts, 1)
+	assert.Equal(t, "P4", percentProducts[0].ProductNumber)
+}
+
+func TestProductRepositoryImpl_Search_PriceRangeAndInStock(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	_ = repo.Create(&domain.Product{ProductNumber: "P1", ProductName: "Cheap", Price: 500, StockQuantity: 3})
+	_ = repo.Create(&domain.Product{ProductNumber: "P2", ProductName: "Sold Out", Price: 1500})
+	_ = repo.Create(&domain.Product{ProductNumber: "P3", ProductName: "In Stock", Price: 2000, StockQuantity: 1})
+	_ = repo.Create(&domain.Product{ProductNumber: "P4", ProductName: "T-Shirt", Price: 2500, Options: []domain.ProductOption{{Name: "size", Values: []string{"S"}}}})
+	_ = repo.Create(&domain.Product{ProductNumber: "P5", ProductName: "T-Shirt (S)", Price: 2500, StockQuantity: 2, ParentProductNumber: "P4", SKU: "TSHIRT-S"})
+	minPrice, maxPrice := int64(1000), int64(3000)
+	query := &domain.ProductQuery{MinPrice: &minPrice, MaxPrice: &maxPrice, InStock: true, Sort: domain.ProductSortPriceAsc}
+
+	// When
+	products, next, err := repo.Search(query)
+	count, countErr := repo.Count(query)
+
+	// Then
+	assert.NoError(t, err)
+	assert.NoError(t, countErr)
+	assert.Nil(t, next)
+	assert.Len(t, products, 2)
+	assert.Equal(t, "P3", products[0].ProductNumber)
+	assert.Equal(t, "P4", products[1].ProductNumber)
+	assert.EqualValues(t, 2, count)
+}
+
+func TestProductRepositoryImpl_Search_PriceCursorPagination(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	_ = repo.Create(&domain.Product{ProductNumber: "P1", ProductName: "One", Price: 3000})
+	_ = repo.Create(&domain.Product{ProductNumber: "P2", ProductName: "Two", Price: 1000})
+	_ = repo.Create(&domain.Product{ProductNumber: "P3", ProductName: "Three", Price: 2000})
+	_ = repo.Create(&domain.Product{ProductNumber: "P4", ProductName: "Four", Price: 2000})
+	query := &domain.ProductQuery{Sort: domain.ProductSortPriceDesc, Limit: 2}
+
+	// When
+	firstPage, next, err := repo.Search(query)
+	query.Cursor = next
+	secondPage, last, _ := repo.Search(query)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"P1", "P4"}, []string{firstPage[0].ProductNumber, firstPage[1].ProductNumber})
+	assert.Equal(t, &domain.ProductCursor{Sort: domain.ProductSortPriceDesc, Key: 2000, ID: firstPage[1].ID}, next)
+	assert.Equal(t, []string{"P3", "P2"}, []string{secondPage[0].ProductNumber, secondPage[1].ProductNumber})
+	assert.Nil(t, last)
+}
+
+func TestProductRepositoryImpl_Search_RelevanceCursorPagination(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	_ = repo.Create(&domain.Product{ProductNumber: "P1", ProductName: "Bread", Description: "goes well with pizza", Price: 1000})
+	_ = repo.Create(&domain.Product{ProductNumber: "P2", ProductName: "Cheese Pizza", Price: 1000})
+	_ = repo.Create(&domain.Product{ProductNumber: "P3", ProductName: "Pizza", Price: 1000})
+	_ = repo.Create(&domain.Product{ProductNumber: "P4", ProductName: "Pizza Cutter", Price: 1000})
+	query := &domain.ProductQuery{Keyword: "pizza", Sort: domain.ProductSortRelevance, Limit: 3}
+
+	// When
+	firstPage, next, err := repo.Search(query)
+	query.Cursor = next
+	secondPage, last, _ := repo.Search(query)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, firstPage, 3)
+	assert.Equal(t, "P3", firstPage[0].ProductNumber)
+	assert.Equal(t, "P4", firstPage[1].ProductNumber)
+	assert.Equal(t, "P2", firstPage[2].ProductNumber)
+	assert.EqualValues(t, 1, next.Key)
+	assert.Len(t, secondPage, 1)
+	assert.Equal(t, "P1", secondPage[0].ProductNumber)
+	assert.Nil(t, last)
+}
+
+func TestProductRepositoryImpl_GetVariants_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	parent := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "T-Shirt",
+		Price:         10000,
+		Options:       []domain.ProductOption{{Name: "size", Values: []string{"S", "M"}}},
+	}
+	small := &domain.Product{
+		ProductNumber:       "P12346",
+		ProductName:         "T-Shirt (S)",
+		Price:               10000,
+		StockQuantity:       3,
+		ParentProductNumber: "P12345",
+		SKU:                 "TSHIRT-S",
+		OptionValues:        map[string]string{"size": "S"},
+	}
+	medium := &domain.Product{
+		ProductNumber:       "P12347",
+		ProductName:         "T-Shirt (M)",
+		Price:               10000,
+		StockQuantity:       5,
+		ParentProductNumber: "P12345",
+		SKU:                 "TSHIRT-M",
+		OptionValues:        map[string]string{"size": "M"},
+	}
+	_ = repo.Create(parent)
+	_ = repo.Create(small)
+	_ = repo.Create(medium)
+
+	// When
+	variants, err := repo.GetVariants("P12345")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, variants, 2)
+	assert.Equal(t, "S", variants[0].OptionValues["size"])
+	assert.Equal(t, "TSHIRT-M", variants[1].SKU)
+
+	products, _, _ := repo.Search(&domain.ProductQuery{})
+	assert.Len(t, products, 1)
+	assert.Equal(t, "P12345", products[0].ProductNumber)
+	assert.Equal(t, []string{"S", "M"}, products[0].Options[0].Values)
+
+	found, err := repo.GetBySKU("TSHIRT-S")
+	assert.NoError(t, err)
+	assert.Equal(t, "P12346", found.ProductNumber)
+}
+
+func TestProductRepositoryImpl_Update_Success_InheritedVariantFollowsParentPrice(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	parent := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "T-Shirt",
+		Price:         10000,
+		Options:       []domain.ProductOption{{Name: "size", Values: []string{"S", "M"}}},
+	}
+	price := int64(12000)
+	inherited, _ := parent.NewVariant("P12346", "TSHIRT-S", map[string]string{"size": "S"}, nil, 3)
+	overridden, _ := parent.NewVariant("P12347", "TSHIRT-M", map[string]string{"size": "M"}, &price, 5)
+	_ = repo.Create(parent)
+	_ = repo.Create(inherited)
+	_ = repo.Create(overridden)
+
+	// When
+	_, _ = parent.ChangePrice(15000, "ADMIN1")
+	err := repo.Update(parent)
+
+	// Then
+	assert.NoError(t, err)
+	foundInherited, _ := repo.GetBySKU("TSHIRT-S")
+	assert.Equal(t, int64(15000), foundInherited.Price)
+	assert.Nil(t, foundInherited.PriceOverride)
+	foundOverridden, _ := repo.GetBySKU("TSHIRT-M")
+	assert.Equal(t, int64(12000), foundOverridden.Price)
+}
+
 func TestProductRepositoryImpl_GetById_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
@@ -176,6 +390,32 @@ func TestProductRepositoryImpl_Update_Success(t *testing.T) {
 	assert.Equal(t, "New Name", updatedProduct.ProductName)
 }
 
+func TestProductRepositoryImpl_Update_Success_KeepsStockQuantity(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Old Name",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = repo.Create(product)
+	stale, _ := repo.GetByProductNumber("P12345")
+	movement, _ := domain.NewStockMovement("P12345", domain.StockMovementOrder, -4, "M12345", "O12345")
+	_ = repo.ApplyStockMovement(movement)
+
+	// When
+	stale.ProductName = "New Name"
+	err := repo.Update(stale)
+
+	// Then
+	assert.NoError(t, err)
+	updatedProduct, _ := repo.GetByProductNumber("P12345")
+	assert.Equal(t, "New Name", updatedProduct.ProductName)
+	assert.Equal(t, 6, updatedProduct.StockQuantity)
+}
+
 func TestProductRepositoryImpl_Delete_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
@@ -200,3 +440,190 @@ func TestProductRepositoryImpl_Delete_Success(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, deletedProduct)
 }
+
+func TestProductRepositoryImpl_ApplyStockMovement_Success_Decrease(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = repo.Create(product)
+
+	// When
+	movement, _ := domain.NewStockMovement("P12345", domain.StockMovementOrder, -4, "M12345", "O12345")
+	err := repo.ApplyStockMovement(movement)
+
+	// Then
+	assert.NoError(t, err)
+	updatedProduct, _ := repo.GetByProductNumber("P12345")
+	assert.Equal(t, 6, updatedProduct.StockQuantity)
+	assert.Equal(t, 6, movement.Balance)
+
+	movements, _ := repo.GetStockMovements("P12345")
+	assert.Len(t, movements, 1)
+	assert.Equal(t, domain.StockMovementOrder, movements[0].Type)
+	assert.Equal(t, -4, movements[0].Delta)
+	assert.Equal(t, "O12345", movements[0].Reference)
+}
+
+func TestProductRepositoryImpl_ApplyStockMovement_Failure_InsufficientStock(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 3,
+	}
+	_ = repo.Create(product)
+
+	// When
+	movement, _ := domain.NewStockMovement("P12345", domain.StockMovementOrder, -4, "M12345", "O12345")
+	err := repo.ApplyStockMovement(movement)
+
+	// Then
+	assert.ErrorIs(t, err, domain.ErrInsufficientStock)
+	unchangedProduct, _ := repo.GetByProductNumber("P12345")
+	assert.Equal(t, 3, unchangedProduct.StockQuantity)
+
+	movements, _ := repo.GetStockMovements("P12345")
+	assert.Empty(t, movements)
+}
+
+func TestProductRepositoryImpl_ApplyStockMovement_Success_Increase(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 3,
+	}
+	_ = repo.Create(product)
+
+	// When
+	movement, _ := domain.NewStockMovement("P12345", domain.StockMovementCancel, 2, "M12345", "O12345")
+	err := repo.ApplyStockMovement(movement)
+
+	// Then
+	assert.NoError(t, err)
+	updatedProduct, _ := repo.GetByProductNumber("P12345")
+	assert.Equal(t, 5, updatedProduct.StockQuantity)
+}
+
+func TestProductRepositoryImpl_ApplyStockMovement_Failure_NotFound(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+
+	// When
+	movement, _ := domain.NewStockMovement("nonexistent", domain.StockMovementCancel, 2, "M12345", "O12345")
+	err := repo.ApplyStockMovement(movement)
+
+	// Then
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestProductRepositoryImpl_ApplyStockMovementIfCurrent_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = repo.Create(product)
+
+	// When
+	movement, _ := domain.NewStockMovement("P12345", domain.StockMovementAdjustment, 5, "ADMIN1", "")
+	err := repo.ApplyStockMovementIfCurrent(movement, 10)
+
+	// Then
+	assert.NoError(t, err)
+	updatedProduct, _ := repo.GetByProductNumber("P12345")
+	assert.Equal(t, 15, updatedProduct.StockQuantity)
+	assert.Equal(t, 15, movement.Balance)
+}
+
+func TestProductRepositoryImpl_ApplyStockMovementIfCurrent_Failure_Conflict(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = repo.Create(product)
+
+	// When
+	movement, _ := domain.NewStockMovement("P12345", domain.StockMovementAdjustment, 5, "ADMIN1", "")
+	err := repo.ApplyStockMovementIfCurrent(movement, 8)
+
+	// Then
+	assert.ErrorIs(t, err, domain.ErrStockConflict)
+	unchangedProduct, _ := repo.GetByProductNumber("P12345")
+	assert.Equal(t, 10, unchangedProduct.StockQuantity)
+	movements, _ := repo.GetStockMovements("P12345")
+	assert.Empty(t, movements)
+}
+
+func TestProductRepositoryImpl_GetPriceChanges_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	first, _ := domain.NewProductPriceChange("P12345", 1000, 1200, "ADMIN1")
+	second, _ := domain.NewProductPriceChange("P12345", 1200, 900, "ADMIN2")
+	other, _ := domain.NewProductPriceChange("P99999", 500, 600, "ADMIN1")
+	_ = repo.CreatePriceChange(first)
+	_ = repo.CreatePriceChange(second)
+	_ = repo.CreatePriceChange(other)
+
+	// When
+	changes, err := repo.GetPriceChanges("P12345")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, int64(1200), changes[0].NewPrice)
+	assert.Equal(t, int64(900), changes[1].NewPrice)
+	assert.Equal(t, "ADMIN2", changes[1].ChangedBy)
+}
+
+func TestProductRepositoryImpl_WithTx_Rollback(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewProductRepository(db)
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = repo.Create(product)
+
+	// When
+	err := db.Transaction(func(tx *gorm.DB) error {
+		movement, _ := domain.NewStockMovement("P12345", domain.StockMovementOrder, -4, "M12345", "O12345")
+		if err := repo.WithTx(tx).ApplyStockMovement(movement); err != nil {
+			return err
+		}
+		return errors.New("rollback")
+	})
+
+	// Then
+	assert.Error(t, err)
+	unchangedProduct, _ := repo.GetByProductNumber("P12345")
+	assert.Equal(t, 10, unchangedProduct.StockQuantity)
+	movements, _ := repo.GetStockMovements("P12345")
+	assert.Empty(t, movements)
+}
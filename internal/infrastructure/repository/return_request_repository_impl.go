@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	domainRepository "github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReturnRequestRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewReturnRequestRepository(db *gorm.DB) *ReturnRequestRepositoryImpl {
+	return &ReturnRequestRepositoryImpl{db: db}
+}
+
+func (r *ReturnRequestRepositoryImpl) WithTx(tx *gorm.DB) domainRepository.ReturnRequestRepository {
+	return &ReturnRequestRepositoryImpl{db: tx}
+}
+
+func (r *ReturnRequestRepositoryImpl) Create(returnRequest *domain.ReturnRequest) error {
+	return r.db.Create(returnRequest).Error
+}
+
+func (r *ReturnRequestRepositoryImpl) GetById(id int) (*domain.ReturnRequest, error) {
+	var returnRequest domain.ReturnRequest
+	if err := r.db.First(&returnRequest, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &returnRequest, nil
+}
+
+func (r *ReturnRequestRepositoryImpl) GetByIdForUpdate(id int) (*domain.ReturnRequest, error) {
+	var returnRequest domain.ReturnRequest
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&returnRequest, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &returnRequest, nil
+}
+
+func (r *ReturnRequestRepositoryImpl) GetByMemberNumber(memberNumber string) ([]*domain.ReturnRequest, error) {
+	var returnRequests []*domain.ReturnRequest
+	if err := r.db.Where("member_number = ?", memberNumber).Order("id DESC").Find(&returnRequests).Error; err != nil {
+		return nil, err
+	}
+	return returnRequests, nil
+}
+
+func (r *ReturnRequestRepositoryImpl) GetByStatus(status domain.ReturnStatus) ([]*domain.ReturnRequest, error) {
+	var returnRequests []*domain.ReturnRequest
+	query := r.db.Order("id DESC")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Find(&returnRequests).Error; err != nil {
+		return nil, err
+	}
+	return returnRequests, nil
+}
+
+func (r *ReturnRequestRepositoryImpl) Update(returnRequest *domain.ReturnRequest) error {
+	return r.db.Save(returnRequest).Error
+}
@@ -0,0 +1,68 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReturnRequestRepositoryImpl_Create_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewReturnRequestRepository(db)
+	returnRequest := &domain.ReturnRequest{
+		OrderID:       12345,
+		MemberNumber:  "M12345",
+		ProductNumber: "P12345",
+		Quantity:      1,
+		Amount:        1000,
+		Reason:        "파손",
+		Status:        domain.ReturnStatusRequested,
+		RequestedAt:   time.Now(),
+	}
+
+	// When
+	err := repo.Create(returnRequest)
+
+	// Then
+	assert.NoError(t, err)
+	savedReturn, err := repo.GetById(returnRequest.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "파손", savedReturn.Reason)
+}
+
+func TestReturnRequestRepositoryImpl_GetByStatus_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewReturnRequestRepository(db)
+	_ = repo.Create(&domain.ReturnRequest{OrderID: 1, MemberNumber: "M12345", ProductNumber: "P12345", Quantity: 1, Amount: 1000, Reason: "파손", Status: domain.ReturnStatusRequested, RequestedAt: time.Now()})
+	_ = repo.Create(&domain.ReturnRequest{OrderID: 2, MemberNumber: "M12346", ProductNumber: "P12345", Quantity: 1, Amount: 1000, Reason: "단순 변심", Status: domain.ReturnStatusApproved, RequestedAt: time.Now()})
+
+	// When
+	requested, err := repo.GetByStatus(domain.ReturnStatusRequested)
+	all, _ := repo.GetByStatus("")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, requested, 1)
+	assert.Len(t, all, 2)
+}
+
+func TestReturnRequestRepositoryImpl_GetByMemberNumber_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewReturnRequestRepository(db)
+	_ = repo.Create(&domain.ReturnRequest{OrderID: 1, MemberNumber: "M12345", ProductNumber: "P12345", Quantity: 1, Amount: 1000, Reason: "파손", Status: domain.ReturnStatusRequested, RequestedAt: time.Now()})
+	_ = repo.Create(&domain.ReturnRequest{OrderID: 2, MemberNumber: "M12346", ProductNumber: "P12345", Quantity: 1, Amount: 1000, Reason: "파손", Status: domain.ReturnStatusRequested, RequestedAt: time.Now()})
+
+	// When
+	returnRequests, err := repo.GetByMemberNumber("M12345")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, returnRequests, 1)
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	domainRepository "github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ShipmentRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewShipmentRepository(db *gorm.DB) *ShipmentRepositoryImpl {
+	return &ShipmentRepositoryImpl{db: db}
+}
+
+func (r *ShipmentRepositoryImpl) WithTx(tx *gorm.DB) domainRepository.ShipmentRepository {
+	return &ShipmentRepositoryImpl{db: tx}
+}
+
+func (r *ShipmentRepositoryImpl) Create(shipment *domain.Shipment) error {
+	return r.db.Create(shipment).Error
+}
+
+func (r *ShipmentRepositoryImpl) GetById(id int) (*domain.Shipment, error) {
+	var shipment domain.Shipment
+	if err := r.db.First(&shipment, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &shipment, nil
+}
+
+func (r *ShipmentRepositoryImpl) GetByIdForUpdate(id int) (*domain.Shipment, error) {
+	var shipment domain.Shipment
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&shipment, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &shipment, nil
+}
+
+func (r *ShipmentRepositoryImpl) GetByOrderID(orderID int) ([]*domain.Shipment, error) {
+	var shipments []*domain.Shipment
+	if err := r.db.Where("order_id = ?", orderID).Order("id ASC").Find(&shipments).Error; err != nil {
+		return nil, err
+	}
+	return shipments, nil
+}
+
+func (r *ShipmentRepositoryImpl) Update(shipment *domain.Shipment) error {
+	return r.db.Save(shipment).Error
+}
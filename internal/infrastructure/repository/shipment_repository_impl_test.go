@@ -0,0 +1,45 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShipmentRepositoryImpl_GetByOrderID_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewShipmentRepository(db)
+	first, _ := domain.NewShipment(12345, "fake", "FAKE-O12345-1")
+	second, _ := domain.NewShipment(12345, "fake", "FAKE-O12345-2")
+	other, _ := domain.NewShipment(12346, "fake", "FAKE-O12346-3")
+	_ = repo.Create(first)
+	_ = repo.Create(second)
+	_ = repo.Create(other)
+
+	// When
+	shipments, err := repo.GetByOrderID(12345)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, shipments, 2)
+	assert.Equal(t, "FAKE-O12345-1", shipments[0].TrackingNumber)
+}
+
+func TestShipmentRepositoryImpl_Create_Failure_DuplicateTrackingNumber(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewShipmentRepository(db)
+	first, _ := domain.NewShipment(12345, "fake", "FAKE-O12345-1")
+	_ = repo.Create(first)
+	duplicate, _ := domain.NewShipment(12346, "fake", "FAKE-O12345-1")
+
+	// When
+	err := repo.Create(duplicate)
+
+	// Then
+	assert.Error(t, err)
+}
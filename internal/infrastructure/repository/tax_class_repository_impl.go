@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	domainRepository "github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type TaxClassRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTaxClassRepository(db *gorm.DB) *TaxClassRepositoryImpl {
+	return &TaxClassRepositoryImpl{db: db}
+}
+
+func (r *TaxClassRepositoryImpl) WithTx(tx *gorm.DB) domainRepository.TaxClassRepository {
+	return &TaxClassRepositoryImpl{db: tx}
+}
+
+func (r *TaxClassRepositoryImpl) Create(taxClass *domain.TaxClass) error {
+	return r.db.Create(taxClass).Error
+}
+
+func (r *TaxClassRepositoryImpl) GetAll() ([]*domain.TaxClass, error) {
+	var taxClasses []*domain.TaxClass
+	if err := r.db.Order("id ASC").Find(&taxClasses).Error; err != nil {
+		return nil, err
+	}
+	return taxClasses, nil
+}
+
+func (r *TaxClassRepositoryImpl) GetById(id int) (*domain.TaxClass, error) {
+	var taxClass domain.TaxClass
+	if err := r.db.First(&taxClass, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &taxClass, nil
+}
+
+func (r *TaxClassRepositoryImpl) GetByName(name string) (*domain.TaxClass, error) {
+	var taxClass domain.TaxClass
+	if err := r.db.First(&taxClass, "name = ?", name).Error; err != nil {
+		return nil, err
+	}
+	return &taxClass, nil
+}
+
+func (r *TaxClassRepositoryImpl) Update(taxClass *domain.TaxClass) error {
+	return r.db.Save(taxClass).Error
+}
+
+func (r *TaxClassRepositoryImpl) Delete(id int) error {
+	return r.db.Delete(&domain.TaxClass{}, "id = ?", id).Error
+}
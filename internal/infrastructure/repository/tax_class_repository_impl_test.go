@@ -0,0 +1,48 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxClassRepositoryImpl_Create_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewTaxClassRepository(db)
+	taxClass := &domain.TaxClass{
+		Name:       "식품 경감세율",
+		Rate:       800,
+		PriceMode:  domain.TaxPriceModeInclusive,
+		Categories: []string{"food"},
+	}
+
+	// When
+	err := repo.Create(taxClass)
+
+	// Then
+	assert.NoError(t, err)
+	savedTaxClass, err := repo.GetByName("식품 경감세율")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"food"}, savedTaxClass.Categories)
+	assert.Equal(t, domain.TaxPriceModeInclusive, savedTaxClass.PriceMode)
+}
+
+func TestTaxClassRepositoryImpl_Delete_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	repo := repository.NewTaxClassRepository(db)
+	taxClass := &domain.TaxClass{Name: "표준세율", Rate: 1000, PriceMode: domain.TaxPriceModeExclusive, IsDefault: true}
+	_ = repo.Create(taxClass)
+
+	// When
+	err := repo.Delete(taxClass.ID)
+
+	// Then
+	assert.NoError(t, err)
+	taxClasses, _ := repo.GetAll()
+	assert.Empty(t, taxClasses)
+}
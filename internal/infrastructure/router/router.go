@@ -5,7 +5,10 @@ import (
 	"net/http"
 
 	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/helper"
 	"github.com/HongJungWan/commerce-system/internal/infrastructure/configs"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/gateway"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/migration"
 	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
 	"github.com/HongJungWan/commerce-system/internal/interfaces/controller"
 	"github.com/HongJungWan/commerce-system/internal/usecases"
@@ -18,11 +21,12 @@ import (
 
 func NewRouter(conf configs.Config, db *gorm.DB) *gin.Engine {
 	service := gin.New()
+	service.Use(gin.Recovery())
 
 	config := cors.DefaultConfig()
 	config.AllowOrigins = []string{"*"}
 	config.AllowMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
-	config.AllowHeaders = []string{"Origin", "Content-Type", "Authorization"}
+	config.AllowHeaders = []string{"Origin", "Content-Type", "Authorization", middleware.IdempotencyKeyHeader}
 	config.AllowCredentials = true
 
 	service.Use(cors.New(config))
@@ -33,8 +37,26 @@ func NewRouter(conf configs.Config, db *gorm.DB) *gin.Engine {
 
 	// 데이터베이스 마이그레이션
 	db.AutoMigrate(&domain.Member{})
+	db.AutoMigrate(&domain.Address{})
+	db.AutoMigrate(&domain.Category{})
 	db.AutoMigrate(&domain.Product{})
+	db.AutoMigrate(&domain.StockMovement{})
+	db.AutoMigrate(&domain.ProductPriceChange{})
 	db.AutoMigrate(&domain.Order{})
+	db.AutoMigrate(&domain.OrderItem{})
+	db.AutoMigrate(&domain.OrderCancellation{})
+	db.AutoMigrate(&domain.Payment{})
+	db.AutoMigrate(&domain.ReturnRequest{})
+	db.AutoMigrate(&domain.Shipment{})
+	db.AutoMigrate(&domain.Cart{})
+	db.AutoMigrate(&domain.CartItem{})
+	db.AutoMigrate(&domain.Coupon{})
+	db.AutoMigrate(&domain.CouponUsage{})
+	db.AutoMigrate(&domain.TaxClass{})
+	db.AutoMigrate(&domain.IdempotencyKey{})
+	if err := migration.Run(db); err != nil {
+		helper.ErrorPanic(err)
+	}
 
 	// Health Check 관련 설정
 	healthCheckInteractor := usecases.NewHealthCheckInteractor()
@@ -47,19 +69,80 @@ func NewRouter(conf configs.Config, db *gorm.DB) *gin.Engine {
 	memberController := controller.NewMemberController(memberInteractor, authInteractor)
 	authController := controller.NewAuthController(authInteractor)
 
+	// 배송지 관련 설정
+	addressRepo := repository.NewAddressRepository(db)
+	addressInteractor := usecases.NewAddressInteractor(addressRepo, db)
+	addressController := controller.NewAddressController(addressInteractor)
+
+	// 카테고리 관련 설정
+	categoryRepo := repository.NewCategoryRepository(db)
+	categoryInteractor := usecases.NewCategoryInteractor(categoryRepo)
+	categoryController := controller.NewCategoryController(categoryInteractor)
+
 	// 상품 관련 설정
 	productRepo := repository.NewProductRepository(db)
-	productInteractor := usecases.NewProductInteractor(productRepo, db)
+	productInteractor := usecases.NewProductInteractor(productRepo, categoryRepo, db)
 	productController := controller.NewProductController(productInteractor)
 
+	// 쿠폰 관련 설정
+	couponRepo := repository.NewCouponRepository(db)
+	couponInteractor := usecases.NewCouponInteractor(couponRepo)
+	couponController := controller.NewCouponController(couponInteractor)
+
+	// 과세 분류 관련 설정
+	taxClassRepo := repository.NewTaxClassRepository(db)
+	taxClassInteractor := usecases.NewTaxClassInteractor(taxClassRepo)
+	taxClassController := controller.NewTaxClassController(taxClassInteractor)
+
 	// 주문 관련 설정
 	orderRepo := repository.NewOrderRepository(db)
-	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo)
+	var shippingCalculator usecases.ShippingCalculator
+	if rateTable := conf.Shipping.RateTable(); rateTable != nil {
+		if err := rateTable.Validate(); err != nil {
+			helper.ErrorPanic(err)
+		}
+		shippingCalculator = usecases.NewRateTableShippingCalculator(rateTable)
+	}
+	pricingEngine := usecases.NewPricingEngine(usecases.NewTaxClassCalculator(taxClassRepo), shippingCalculator)
+	paymentGateway, err := gateway.NewPaymentGateway(conf.Payment.Provider)
+	if err != nil {
+		helper.ErrorPanic(err)
+	}
+	cancellationPolicy := conf.Cancellation.Policy()
+	if cancellationPolicy != nil {
+		if err := cancellationPolicy.Validate(); err != nil {
+			helper.ErrorPanic(err)
+		}
+	}
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, couponRepo, addressRepo, categoryRepo, pricingEngine, paymentGateway, cancellationPolicy, db)
 	orderController := controller.NewOrderController(orderInteractor)
 
+	// 반품 관련 설정
+	returnRepo := repository.NewReturnRequestRepository(db)
+	returnInteractor := usecases.NewReturnInteractor(returnRepo, orderRepo, productRepo, paymentGateway, db)
+	returnController := controller.NewReturnController(returnInteractor)
+
+	// 배송 관련 설정
+	shipmentRepo := repository.NewShipmentRepository(db)
+	carrierGateway, err := gateway.NewCarrierGateway(conf.Carrier.Provider)
+	if err != nil {
+		helper.ErrorPanic(err)
+	}
+	shipmentInteractor := usecases.NewShipmentInteractor(shipmentRepo, orderRepo, carrierGateway, paymentGateway, db)
+	shipmentController := controller.NewShipmentController(shipmentInteractor)
+
+	// 장바구니 관련 설정
+	cartRepo := repository.NewCartRepository(db)
+	cartInteractor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+	cartController := controller.NewCartController(cartInteractor)
+
 	// JWT 미들웨어 설정
 	authMiddleware := middleware.JWTAuthMiddleware()
 
+	// 멱등 키 미들웨어 설정
+	idempotencyKeyRepo := repository.NewIdempotencyKeyRepository(db)
+	idempotencyMiddleware := middleware.IdempotencyMiddleware(idempotencyKeyRepo)
+
 	router := service.Group("/api")
 
 	// 헬스체크 엔드포인트 설정
@@ -76,17 +159,81 @@ func NewRouter(conf configs.Config, db *gorm.DB) *gin.Engine {
 	router.GET("/members", authMiddleware, memberController.GetAllMembers)
 	router.GET("/members/stats", authMiddleware, memberController.GetMemberStats)
 
+	// 배송지 엔드포인트 설정
+	router.POST("/members/me/addresses", authMiddleware, addressController.CreateAddress)
+	router.GET("/members/me/addresses", authMiddleware, addressController.GetMyAddresses)
+	router.PUT("/members/me/addresses/:id", authMiddleware, addressController.UpdateAddress)
+	router.DELETE("/members/me/addresses/:id", authMiddleware, addressController.DeleteAddress)
+
 	// 상품 엔드포인트 설정
 	router.GET("/products", productController.GetProducts)
 	router.POST("/products", authMiddleware, productController.CreateProduct)
 	router.PUT("/products/:id/stock", authMiddleware, productController.UpdateStock)
+	router.POST("/products/:id/stock/adjust", authMiddleware, productController.AdjustStock)
+	router.GET("/products/:id/stock-movements", authMiddleware, productController.GetStockMovements)
+	router.POST("/products/:id/variants", authMiddleware, productController.AddVariant)
+	router.PATCH("/products/:id", authMiddleware, productController.UpdateProduct)
+	router.GET("/products/:id/price-history", authMiddleware, productController.GetPriceHistory)
+	router.POST("/products/:id/archive", authMiddleware, productController.ArchiveProduct)
+	router.POST("/products/:id/restore", authMiddleware, productController.RestoreProduct)
 	router.DELETE("/products/:id", authMiddleware, productController.DeleteProduct)
 
+	// 카테고리 엔드포인트 설정
+	router.POST("/categories", authMiddleware, categoryController.CreateCategory)
+	router.GET("/categories", categoryController.GetCategories)
+	router.GET("/categories/:id", categoryController.GetCategory)
+	router.PUT("/categories/:id", authMiddleware, categoryController.UpdateCategory)
+	router.DELETE("/categories/:id", authMiddleware, categoryController.DeleteCategory)
+
+	// 쿠폰 엔드포인트 설정
+	router.POST("/coupons", authMiddleware, couponController.CreateCoupon)
+	router.GET("/coupons", authMiddleware, couponController.GetCoupons)
+	router.GET("/coupons/:id", authMiddleware, couponController.GetCoupon)
+	router.PUT("/coupons/:id", authMiddleware, couponController.UpdateCoupon)
+	router.DELETE("/coupons/:id", authMiddleware, couponController.DeleteCoupon)
+
+	// 과세 분류 엔드포인트 설정
+	router.POST("/tax-classes", authMiddleware, taxClassController.CreateTaxClass)
+	router.GET("/tax-classes", authMiddleware, taxClassController.GetTaxClasses)
+	router.GET("/tax-classes/:id", authMiddleware, taxClassController.GetTaxClass)
+	router.PUT("/tax-classes/:id", authMiddleware, taxClassController.UpdateTaxClass)
+	router.DELETE("/tax-classes/:id", authMiddleware, taxClassController.DeleteTaxClass)
+
 	// 주문 엔드포인트 설정
-	router.POST("/orders", authMiddleware, orderController.CreateOrder)
+	router.POST("/orders", authMiddleware, idempotencyMiddleware, orderController.CreateOrder)
+	router.POST("/orders/quote", authMiddleware, orderController.QuoteOrder)
 	router.GET("/orders/me", authMiddleware, orderController.GetMyOrders)
-	router.PUT("/orders/:id/cancel", authMiddleware, orderController.CancelOrder)
+	router.GET("/v2/orders/me", authMiddleware, orderController.GetMyOrdersV2)
+	router.GET("/orders/:id", authMiddleware, orderController.GetOrder)
+	router.PUT("/orders/:id/cancel", authMiddleware, idempotencyMiddleware, orderController.CancelOrder)
+	router.PUT("/orders/:id/partial-cancel", authMiddleware, idempotencyMiddleware, orderController.PartialCancelOrder)
+	router.PUT("/orders/:id/status", authMiddleware, orderController.ChangeOrderStatus)
 	router.GET("/orders/stats", authMiddleware, orderController.GetMonthlyStats)
 
+	// 관리자 주문 엔드포인트 설정
+	router.GET("/admin/orders", authMiddleware, orderController.SearchOrders)
+	router.GET("/admin/orders/:order_number", authMiddleware, orderController.GetOrderByNumber)
+	router.PUT("/admin/orders/:order_number/cancel", authMiddleware, idempotencyMiddleware, orderController.AdminCancelOrder)
+
+	// 배송 엔드포인트 설정
+	router.POST("/orders/:id/shipments", authMiddleware, shipmentController.CreateShipment)
+	router.GET("/orders/:id/shipments", authMiddleware, shipmentController.GetOrderShipments)
+	router.GET("/shipments/pending", authMiddleware, shipmentController.GetOrdersAwaitingShipment)
+	router.PUT("/shipments/:id/deliver", authMiddleware, shipmentController.DeliverShipment)
+	router.PUT("/shipments/:id/tracking", authMiddleware, shipmentController.SyncTracking)
+
+	// 반품 엔드포인트 설정
+	router.POST("/orders/:id/returns", authMiddleware, idempotencyMiddleware, returnController.RequestReturn)
+	router.GET("/returns/me", authMiddleware, returnController.GetMyReturns)
+	router.GET("/returns", authMiddleware, returnController.GetReturns)
+	router.PUT("/returns/:id/status", authMiddleware, returnController.ChangeReturnStatus)
+
+	// 장바구니 엔드포인트 설정
+	router.GET("/cart", authMiddleware, cartController.GetMyCart)
+	router.POST("/cart/items", authMiddleware, cartController.AddItem)
+	router.PUT("/cart/items/:product_number", authMiddleware, cartController.UpdateItem)
+	router.DELETE("/cart/items/:product_number", authMiddleware, cartController.RemoveItem)
+	router.POST("/cart/checkout", authMiddleware, cartController.Checkout)
+
 	return service
 }
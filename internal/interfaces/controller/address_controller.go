@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/gin-gonic/gin"
+)
+
+type AddressController struct {
+	addressInteractor *usecases.AddressInteractor
+}
+
+func NewAddressController(ai *usecases.AddressInteractor) *AddressController {
+	return &AddressController{addressInteractor: ai}
+}
+
+// CreateAddress godoc
+// @Summary      배송지 등록
+// @Description  인증된 사용자의 배송지를 등록합니다. 첫 번째 배송지는 기본 배송지로 지정됩니다.
+// @Tags         addresses
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        addressRequest body request.AddressRequest true "배송지 정보"
+// @Success      201 {object} response.AddressResponse "등록 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      500 {object} map[string]string "등록 실패"
+// @Router       /members/me/addresses [post]
+func (ac *AddressController) CreateAddress(c *gin.Context) {
+	var req request.AddressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	memberNumber := c.GetString("member_number")
+
+	responseData, err := ac.addressInteractor.CreateAddress(memberNumber, &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, responseData)
+}
+
+// GetMyAddresses godoc
+// @Summary      내 배송지 조회
+// @Description  인증된 사용자의 배송지 목록을 조회합니다. 기본 배송지가 먼저 표시됩니다.
+// @Tags         addresses
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Success      200 {array} response.AddressResponse "배송지 목록"
+// @Failure      500 {object} map[string]string "배송지 조회 실패"
+// @Router       /members/me/addresses [get]
+func (ac *AddressController) GetMyAddresses(c *gin.Context) {
+	memberNumber := c.GetString("member_number")
+
+	responseData, err := ac.addressInteractor.GetMyAddresses(memberNumber)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "배송지 목록을 가져올 수 없습니다."})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// UpdateAddress godoc
+// @Summary      배송지 수정
+// @Description  인증된 사용자의 배송지를 수정합니다. 이미 생성된 주문의 배송지는 변경되지 않습니다.
+// @Tags         addresses
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "배송지 기본키 (primary key)"
+// @Param        addressRequest body request.AddressRequest true "수정할 배송지 정보"
+// @Success      200 {object} response.AddressResponse "수정 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      500 {object} map[string]string "수정 실패"
+// @Router       /members/me/addresses/{id} [put]
+func (ac *AddressController) UpdateAddress(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 배송지 ID입니다."})
+		return
+	}
+
+	var req request.AddressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	memberNumber := c.GetString("member_number")
+
+	responseData, err := ac.addressInteractor.UpdateAddress(id, memberNumber, &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// DeleteAddress godoc
+// @Summary      배송지 삭제
+// @Description  인증된 사용자의 배송지를 삭제합니다.
+// @Tags         addresses
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "배송지 기본키 (primary key)"
+// @Success      200 {object} map[string]string "삭제 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      500 {object} map[string]string "삭제 실패"
+// @Router       /members/me/addresses/{id} [delete]
+func (ac *AddressController) DeleteAddress(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 배송지 ID입니다."})
+		return
+	}
+
+	memberNumber := c.GetString("member_number")
+
+	if err := ac.addressInteractor.DeleteAddress(id, memberNumber); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "배송지가 삭제되었습니다."})
+}
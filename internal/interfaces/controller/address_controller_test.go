@@ -0,0 +1,68 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/controller"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddressController_CreateAddress_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	addressInteractor := usecases.NewAddressInteractor(repository.NewAddressRepository(db), db)
+	addressController := controller.NewAddressController(addressInteractor)
+
+	router := gin.Default()
+	router.POST("/members/me/addresses", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		addressController.CreateAddress(c)
+	})
+
+	body := []byte(`{"label":"집","recipient_name":"홍길동","phone":"010-1234-5678","zip_code":"06236","base_address":"서울특별시 강남구 테헤란로 123"}`)
+	req, _ := http.NewRequest("POST", "/members/me/addresses", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "06236", response["zip_code"])
+	assert.Equal(t, true, response["is_default"])
+}
+
+func TestAddressController_UpdateAddress_Failure_InvalidID(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	addressInteractor := usecases.NewAddressInteractor(repository.NewAddressRepository(db), db)
+	addressController := controller.NewAddressController(addressInteractor)
+
+	router := gin.Default()
+	router.PUT("/members/me/addresses/:id", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		addressController.UpdateAddress(c)
+	})
+
+	req, _ := http.NewRequest("PUT", "/members/me/addresses/abc", bytes.NewBuffer([]byte(`{}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
@@ -0,0 +1,153 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/gin-gonic/gin"
+)
+
+type CartController struct {
+	cartInteractor *usecases.CartInteractor
+}
+
+func NewCartController(ci *usecases.CartInteractor) *CartController {
+	return &CartController{cartInteractor: ci}
+}
+
+// GetMyCart godoc
+// @Summary      내 장바구니 조회
+// @Description  인증된 사용자의 장바구니를 현재 상품 가격과 재고 기준으로 조회합니다.
+// @Tags         cart
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} response.CartResponse "장바구니"
+// @Failure      500 {object} map[string]string "장바구니 조회 실패"
+// @Router       /cart [get]
+func (cc *CartController) GetMyCart(c *gin.Context) {
+	memberNumber := c.GetString("member_number")
+
+	responseData, err := cc.cartInteractor.GetMyCart(memberNumber)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "장바구니를 가져올 수 없습니다."})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// AddItem godoc
+// @Summary      장바구니 상품 추가
+// @Description  장바구니에 상품을 추가합니다. 이미 담긴 상품이면 수량을 더합니다.
+// @Tags         cart
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        cartItemRequest body request.AddCartItemRequest true "장바구니 상품 정보"
+// @Success      200 {object} response.CartResponse "추가 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      500 {object} map[string]string "추가 실패"
+// @Router       /cart/items [post]
+func (cc *CartController) AddItem(c *gin.Context) {
+	var req request.AddCartItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	memberNumber := c.GetString("member_number")
+
+	responseData, err := cc.cartInteractor.AddItem(memberNumber, &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// UpdateItem godoc
+// @Summary      장바구니 상품 수량 수정
+// @Description  장바구니에 담긴 상품의 수량을 수정합니다.
+// @Tags         cart
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        product_number path string true "상품번호"
+// @Param        cartItemRequest body request.UpdateCartItemRequest true "수정할 수량"
+// @Success      200 {object} response.CartResponse "수정 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      500 {object} map[string]string "수정 실패"
+// @Router       /cart/items/{product_number} [put]
+func (cc *CartController) UpdateItem(c *gin.Context) {
+	var req request.UpdateCartItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	memberNumber := c.GetString("member_number")
+	productNumber := c.Param("product_number")
+
+	responseData, err := cc.cartInteractor.UpdateItem(memberNumber, productNumber, &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// RemoveItem godoc
+// @Summary      장바구니 상품 삭제
+// @Description  장바구니에서 상품을 삭제합니다.
+// @Tags         cart
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        product_number path string true "상품번호"
+// @Success      200 {object} response.CartResponse "삭제 성공"
+// @Failure      500 {object} map[string]string "삭제 실패"
+// @Router       /cart/items/{product_number} [delete]
+func (cc *CartController) RemoveItem(c *gin.Context) {
+	memberNumber := c.GetString("member_number")
+	productNumber := c.Param("product_number")
+
+	responseData, err := cc.cartInteractor.RemoveItem(memberNumber, productNumber)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// Checkout godoc
+// @Summary      장바구니 주문
+// @Description  장바구니에 담긴 상품으로 주문을 생성하고 장바구니를 비웁니다. 배송지를 지정하지 않으면 기본 배송지를 사용합니다.
+// @Tags         cart
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        request body request.CheckoutCartRequest false "배송지 및 쿠폰"
+// @Success      201 {object} response.CreateOrderResponse "주문 생성 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      500 {object} map[string]string "주문 생성 실패"
+// @Router       /cart/checkout [post]
+func (cc *CartController) Checkout(c *gin.Context) {
+	// 본문 없이 요청하면 기본 배송지로, 쿠폰 없이 주문
+	var req request.CheckoutCartRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+			return
+		}
+	}
+
+	memberNumber := c.GetString("member_number")
+
+	responseData, err := cc.cartInteractor.Checkout(memberNumber, &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, responseData)
+}
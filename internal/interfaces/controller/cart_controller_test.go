@@ -0,0 +1,179 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/gateway"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/controller"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/response"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCartController_AddItem_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	cartInteractor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+	cartController := controller.NewCartController(cartInteractor)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	router := gin.Default()
+	router.POST("/cart/items", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		cartController.AddItem(c)
+	})
+
+	requestBody, _ := json.Marshal(map[string]interface{}{
+		"product_number": "P12345",
+		"quantity":       2,
+	})
+	req, _ := http.NewRequest("POST", "/cart/items", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var cart response.CartResponse
+	err := json.Unmarshal(resp.Body.Bytes(), &cart)
+	assert.NoError(t, err)
+	assert.Len(t, cart.Items, 1)
+	assert.EqualValues(t, 2000, cart.TotalAmount)
+}
+
+func TestCartController_AddItem_Failure_InvalidRequest(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	cartInteractor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+	cartController := controller.NewCartController(cartInteractor)
+
+	router := gin.Default()
+	router.POST("/cart/items", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		cartController.AddItem(c)
+	})
+
+	requestBody, _ := json.Marshal(map[string]interface{}{
+		"quantity": "invalid", // 잘못된 타입의 값
+	})
+	req, _ := http.NewRequest("POST", "/cart/items", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestCartController_Checkout_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	cartInteractor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+	cartController := controller.NewCartController(cartInteractor)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	cart := &domain.Cart{MemberNumber: "M12345"}
+	_ = cart.AddItem("P12345", 3)
+	_ = cartRepo.Save(cart)
+
+	router := gin.Default()
+	router.POST("/cart/checkout", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		cartController.Checkout(c)
+	})
+
+	req, _ := http.NewRequest("POST", "/cart/checkout", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	var order response.CreateOrderResponse
+	err := json.Unmarshal(resp.Body.Bytes(), &order)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3000, order.Order.TotalAmount)
+}
+
+func TestCartController_Checkout_Failure_InvalidRequest(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	cartInteractor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+	cartController := controller.NewCartController(cartInteractor)
+
+	router := gin.Default()
+	router.POST("/cart/checkout", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		cartController.Checkout(c)
+	})
+
+	requestBody, _ := json.Marshal(map[string]interface{}{
+		"address_id": "invalid", // 잘못된 타입의 값
+	})
+	req, _ := http.NewRequest("POST", "/cart/checkout", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
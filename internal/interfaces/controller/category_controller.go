@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/gin-gonic/gin"
+)
+
+type CategoryController struct {
+	categoryInteractor *usecases.CategoryInteractor
+}
+
+func NewCategoryController(ci *usecases.CategoryInteractor) *CategoryController {
+	return &CategoryController{categoryInteractor: ci}
+}
+
+// CreateCategory godoc
+// @Summary      카테고리 생성
+// @Description  새로운 카테고리를 등록합니다. 상위 카테고리를 지정하면 하위 카테고리로 등록됩니다. (관리자 전용)
+// @Tags         categories
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        categoryRequest body request.CreateCategoryRequest true "카테고리 정보"
+// @Success      201 {object} response.CategoryResponse "생성 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "생성 실패"
+// @Router       /categories [post]
+func (cc *CategoryController) CreateCategory(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	var req request.CreateCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	responseData, err := cc.categoryInteractor.CreateCategory(&req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, responseData)
+}
+
+// GetCategories godoc
+// @Summary      카테고리 트리 조회
+// @Description  전체 카테고리를 정렬 순서에 따라 상하위 트리로 조회합니다.
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Success      200 {array} response.CategoryResponse "카테고리 트리"
+// @Failure      500 {object} map[string]string "조회 실패"
+// @Router       /categories [get]
+func (cc *CategoryController) GetCategories(c *gin.Context) {
+	responseData, err := cc.categoryInteractor.GetCategoryTree()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "카테고리 목록을 가져올 수 없습니다."})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// GetCategory godoc
+// @Summary      카테고리 상세 조회
+// @Description  카테고리 정보를 조회합니다.
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Success      200 {object} response.CategoryResponse "카테고리 정보"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      404 {object} map[string]string "카테고리 없음"
+// @Router       /categories/{id} [get]
+func (cc *CategoryController) GetCategory(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 카테고리 ID입니다."})
+		return
+	}
+
+	responseData, err := cc.categoryInteractor.GetCategory(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "카테고리를 찾을 수 없습니다."})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// UpdateCategory godoc
+// @Summary      카테고리 수정
+// @Description  카테고리명, 상위 카테고리, 정렬 순서를 수정합니다. 슬러그는 변경할 수 없습니다. (관리자 전용)
+// @Tags         categories
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Param        categoryRequest body request.UpdateCategoryRequest true "수정할 카테고리 정보"
+// @Success      200 {object} response.CategoryResponse "수정 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "수정 실패"
+// @Router       /categories/{id} [put]
+func (cc *CategoryController) UpdateCategory(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 카테고리 ID입니다."})
+		return
+	}
+
+	var req request.UpdateCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	responseData, err := cc.categoryInteractor.UpdateCategory(id, &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// DeleteCategory godoc
+// @Summary      카테고리 삭제
+// @Description  하위 카테고리와 등록된 상품이 없는 카테고리를 삭제합니다. (관리자 전용)
+// @Tags         categories
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Success      200 {object} map[string]string "삭제 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "삭제 실패"
+// @Router       /categories/{id} [delete]
+func (cc *CategoryController) DeleteCategory(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 카테고리 ID입니다."})
+		return
+	}
+
+	if err := cc.categoryInteractor.DeleteCategory(id); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "카테고리가 삭제되었습니다."})
+}
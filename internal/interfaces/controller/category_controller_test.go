@@ -0,0 +1,125 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/controller"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategoryController_CreateCategory_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	categoryInteractor := usecases.NewCategoryInteractor(repository.NewCategoryRepository(db))
+	categoryController := controller.NewCategoryController(categoryInteractor)
+
+	router := gin.Default()
+	router.POST("/categories", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		categoryController.CreateCategory(c)
+	})
+
+	body := []byte(`{"name":"식품","slug":"food","sort_order":1}`)
+	req, _ := http.NewRequest("POST", "/categories", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "food", response["slug"])
+}
+
+func TestCategoryController_CreateCategory_Failure_Unauthorized(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	categoryInteractor := usecases.NewCategoryInteractor(repository.NewCategoryRepository(db))
+	categoryController := controller.NewCategoryController(categoryInteractor)
+
+	router := gin.Default()
+	router.POST("/categories", func(c *gin.Context) {
+		c.Set("is_admin", false)
+		categoryController.CreateCategory(c)
+	})
+
+	body := []byte(`{"name":"식품","slug":"food"}`)
+	req, _ := http.NewRequest("POST", "/categories", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
+
+func TestCategoryController_GetCategories_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	categoryInteractor := usecases.NewCategoryInteractor(repository.NewCategoryRepository(db))
+	categoryController := controller.NewCategoryController(categoryInteractor)
+	food, _ := categoryInteractor.CreateCategory(&request.CreateCategoryRequest{Name: "식품", Slug: "food"})
+	_, _ = categoryInteractor.CreateCategory(&request.CreateCategoryRequest{Name: "과자", Slug: "snacks", ParentID: &food.ID})
+
+	router := gin.Default()
+	router.GET("/categories", categoryController.GetCategories)
+
+	req, _ := http.NewRequest("GET", "/categories", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var response []map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response, 1)
+	children := response[0]["children"].([]interface{})
+	assert.Len(t, children, 1)
+	assert.Equal(t, "snacks", children[0].(map[string]interface{})["slug"])
+}
+
+func TestCategoryController_DeleteCategory_Failure_HasChildren(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	categoryInteractor := usecases.NewCategoryInteractor(repository.NewCategoryRepository(db))
+	categoryController := controller.NewCategoryController(categoryInteractor)
+	food, _ := categoryInteractor.CreateCategory(&request.CreateCategoryRequest{Name: "식품", Slug: "food"})
+	_, _ = categoryInteractor.CreateCategory(&request.CreateCategoryRequest{Name: "과자", Slug: "snacks", ParentID: &food.ID})
+
+	router := gin.Default()
+	router.DELETE("/categories/:id", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		categoryController.DeleteCategory(c)
+	})
+
+	req, _ := http.NewRequest("DELETE", "/categories/1", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "하위 카테고리가 있어 삭제할 수 없습니다.", response["error"])
+}
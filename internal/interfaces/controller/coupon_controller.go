@@ -0,0 +1,189 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/gin-gonic/gin"
+)
+
+type CouponController struct {
+	couponInteractor *usecases.CouponInteractor
+}
+
+func NewCouponController(ci *usecases.CouponInteractor) *CouponController {
+	return &CouponController{couponInteractor: ci}
+}
+
+// CreateCoupon godoc
+// @Summary      쿠폰 생성
+// @Description  새로운 쿠폰을 등록합니다. (관리자 전용)
+// @Tags         coupons
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        couponRequest body request.CreateCouponRequest true "쿠폰 정보"
+// @Success      201 {object} response.CouponResponse "생성 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "생성 실패"
+// @Router       /coupons [post]
+func (cc *CouponController) CreateCoupon(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	var req request.CreateCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	responseData, err := cc.couponInteractor.CreateCoupon(&req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, responseData)
+}
+
+// GetCoupons godoc
+// @Summary      쿠폰 목록 조회
+// @Description  전체 쿠폰 목록을 조회합니다. (관리자 전용)
+// @Tags         coupons
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Success      200 {array} response.CouponResponse "쿠폰 목록"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "조회 실패"
+// @Router       /coupons [get]
+func (cc *CouponController) GetCoupons(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	responseData, err := cc.couponInteractor.GetCoupons()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "쿠폰 목록을 가져올 수 없습니다."})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// GetCoupon godoc
+// @Summary      쿠폰 상세 조회
+// @Description  쿠폰 정보를 조회합니다. (관리자 전용)
+// @Tags         coupons
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Success      200 {object} response.CouponResponse "쿠폰 정보"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      404 {object} map[string]string "쿠폰 없음"
+// @Router       /coupons/{id} [get]
+func (cc *CouponController) GetCoupon(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 쿠폰 ID입니다."})
+		return
+	}
+
+	responseData, err := cc.couponInteractor.GetCoupon(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "쿠폰을 찾을 수 없습니다."})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// UpdateCoupon godoc
+// @Summary      쿠폰 수정
+// @Description  쿠폰 정보를 수정합니다. 쿠폰 코드는 변경할 수 없습니다. (관리자 전용)
+// @Tags         coupons
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Param        couponRequest body request.UpdateCouponRequest true "수정할 쿠폰 정보"
+// @Success      200 {object} response.CouponResponse "수정 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "수정 실패"
+// @Router       /coupons/{id} [put]
+func (cc *CouponController) UpdateCoupon(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 쿠폰 ID입니다."})
+		return
+	}
+
+	var req request.UpdateCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	responseData, err := cc.couponInteractor.UpdateCoupon(id, &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// DeleteCoupon godoc
+// @Summary      쿠폰 삭제
+// @Description  사용 이력이 없는 쿠폰을 삭제합니다. (관리자 전용)
+// @Tags         coupons
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Success      200 {object} map[string]string "삭제 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "삭제 실패"
+// @Router       /coupons/{id} [delete]
+func (cc *CouponController) DeleteCoupon(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 쿠폰 ID입니다."})
+		return
+	}
+
+	if err := cc.couponInteractor.DeleteCoupon(id); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "쿠폰이 삭제되었습니다."})
+}
@@ -0,0 +1,92 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/controller"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCouponController_CreateCoupon_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	couponRepo := repository.NewCouponRepository(db)
+	couponInteractor := usecases.NewCouponInteractor(couponRepo)
+	couponController := controller.NewCouponController(couponInteractor)
+
+	router := gin.Default()
+	router.POST("/coupons", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		couponController.CreateCoupon(c)
+	})
+
+	body := []byte(`{"code":"WELCOME10","name":"신규 회원 10% 할인","discount_type":"percentage","discount_value":10,"starts_at":"2024-09-01T00:00:00Z","ends_at":"2024-10-01T00:00:00Z","categories":["food"]}`)
+	req, _ := http.NewRequest("POST", "/coupons", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "WELCOME10", response["code"])
+}
+
+func TestCouponController_CreateCoupon_Failure_Unauthorized(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	couponRepo := repository.NewCouponRepository(db)
+	couponInteractor := usecases.NewCouponInteractor(couponRepo)
+	couponController := controller.NewCouponController(couponInteractor)
+
+	router := gin.Default()
+	router.POST("/coupons", func(c *gin.Context) {
+		c.Set("is_admin", false)
+		couponController.CreateCoupon(c)
+	})
+
+	req, _ := http.NewRequest("POST", "/coupons", bytes.NewBuffer([]byte(`{}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
+
+func TestCouponController_GetCoupon_Failure_NotFound(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	couponRepo := repository.NewCouponRepository(db)
+	couponInteractor := usecases.NewCouponInteractor(couponRepo)
+	couponController := controller.NewCouponController(couponInteractor)
+
+	router := gin.Default()
+	router.GET("/coupons/:id", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		couponController.GetCoupon(c)
+	})
+
+	req, _ := http.NewRequest("GET", "/coupons/999", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
@@ -1,10 +1,13 @@
 package controller
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
+	"github.com/HongJungWan/commerce-system/internal/domain"
 	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/response"
 	"github.com/HongJungWan/commerce-system/internal/usecases"
 	"github.com/gin-gonic/gin"
 )
@@ -24,6 +27,7 @@ func NewOrderController(oi *usecases.OrderInteractor) *OrderController {
 // @Security     Bearer
 // @Accept       json
 // @Produce      json
+// @Param        Idempotency-Key header string false "멱등 키"
 // @Param        orderRequest body request.CreateOrderRequest true "주문 정보"
 // @Success      201 {object} response.OrderResponse "주문 생성 성공"
 // @Failure      400 {object} map[string]string "잘못된 요청"
@@ -46,25 +50,230 @@ func (oc *OrderController) CreateOrder(c *gin.Context) {
 	c.JSON(http.StatusCreated, responseData)
 }
 
+// QuoteOrder godoc
+// @Summary      주문 금액 조회
+// @Description  주문을 생성하지 않고 상품 금액, 할인, 세금, 배송비가 포함된 결제 예정 금액을 계산합니다.
+// @Tags         orders
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        orderRequest body request.CreateOrderRequest true "주문 정보"
+// @Success      200 {object} response.PriceBreakdownResponse "금액 계산 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      500 {object} map[string]string "금액 계산 실패"
+// @Router       /orders/quote [post]
+func (oc *OrderController) QuoteOrder(c *gin.Context) {
+	var req request.CreateOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	memberNumber := c.GetString("member_number")
+
+	responseData, err := oc.orderInteractor.QuoteOrder(&req, memberNumber)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
 // GetMyOrders godoc
-// @Summary      내 주문 조회
-// @Description  인증된 사용자의 주문 내역을 조회합니다.
+// @Summary      내 주문 조회 (배열 응답)
+// @Description  인증된 사용자의 주문 내역을 배열로 조회합니다. 한 번에 최대 100건까지 응답하며, 다음 페이지가 있으면 X-Next-Cursor 헤더로 커서를 전달합니다. 신규 연동은 /v2/orders/me 를 사용합니다.
 // @Tags         orders
 // @Security     Bearer
 // @Accept       json
 // @Produce      json
+// @Param        status query string false "주문 상태 (쉼표로 구분)"
+// @Param        from query string false "조회 시작일 (YYYY-MM-DD)"
+// @Param        to query string false "조회 종료일 (YYYY-MM-DD)"
+// @Param        sort query string false "정렬 순서 (latest, oldest)"
+// @Param        cursor query int false "이전 응답의 X-Next-Cursor"
+// @Param        limit query int false "페이지 크기 (기본 100, 최대 100)"
 // @Success      200 {array} response.OrderResponse "주문 목록"
+// @Header       200 {integer} X-Next-Cursor "다음 페이지 커서 (마지막 페이지면 생략)"
+// @Failure      400 {object} map[string]string "잘못된 요청"
 // @Failure      500 {object} map[string]string "주문 조회 실패"
+// @Deprecated
 // @Router       /orders/me [get]
 func (oc *OrderController) GetMyOrders(c *gin.Context) {
+	var req request.OrderHistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+	// 기존 클라이언트는 페이지 크기를 보내지 않으므로 최대 크기로 응답
+	if req.Limit == 0 {
+		req.Limit = domain.MaxOrderPageSize
+	}
+
 	memberNumber := c.GetString("member_number")
 
-	orderResponses, err := oc.orderInteractor.GetMyOrders(memberNumber)
+	query, err := req.ToQuery(memberNumber)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	responseData, err := oc.orderInteractor.GetMyOrders(query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "주문 내역을 가져올 수 없습니다."})
+		return
+	}
+	if responseData.HasNext {
+		c.Header("X-Next-Cursor", strconv.Itoa(responseData.NextCursor))
+	}
+	c.JSON(http.StatusOK, responseData.Orders)
+}
+
+// GetMyOrdersV2 godoc
+// @Summary      내 주문 조회 (페이지네이션)
+// @Description  인증된 사용자의 주문 내역을 커서 기반으로 페이지 단위 조회합니다.
+// @Tags         orders
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        status query string false "주문 상태 (쉼표로 구분)"
+// @Param        from query string false "조회 시작일 (YYYY-MM-DD)"
+// @Param        to query string false "조회 종료일 (YYYY-MM-DD)"
+// @Param        sort query string false "정렬 순서 (latest, oldest)"
+// @Param        cursor query int false "이전 페이지의 next_cursor"
+// @Param        limit query int false "페이지 크기 (기본 20, 최대 100)"
+// @Success      200 {object} response.OrderListResponse "주문 목록"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      500 {object} map[string]string "주문 조회 실패"
+// @Router       /v2/orders/me [get]
+func (oc *OrderController) GetMyOrdersV2(c *gin.Context) {
+	var req request.OrderHistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	memberNumber := c.GetString("member_number")
+
+	query, err := req.ToQuery(memberNumber)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	responseData, err := oc.orderInteractor.GetMyOrders(query)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "주문 내역을 가져올 수 없습니다."})
 		return
 	}
-	c.JSON(http.StatusOK, orderResponses)
+	c.JSON(http.StatusOK, responseData)
+}
+
+// GetOrder godoc
+// @Summary      주문 상세 조회
+// @Description  주문 상세 정보를 조회합니다. (주문자 또는 관리자)
+// @Tags         orders
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "기본키 (primary key)"
+// @Success      200 {object} response.OrderResponse "주문 상세"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      500 {object} map[string]string "주문 조회 실패"
+// @Router       /orders/{id} [get]
+func (oc *OrderController) GetOrder(c *gin.Context) {
+	orderParam := c.Param("id")
+	id, err := strconv.Atoi(orderParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 주문 ID입니다."})
+		return
+	}
+
+	memberNumber := c.GetString("member_number")
+	isAdmin := c.GetBool("is_admin")
+
+	responseData, err := oc.orderInteractor.GetOrder(id, memberNumber, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// SearchOrders godoc
+// @Summary      주문 검색
+// @Description  전체 회원의 주문을 조건으로 검색합니다. (관리자 전용)
+// @Tags         admin
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        order_number query string false "주문번호"
+// @Param        member_number query string false "회원번호"
+// @Param        product_number query string false "상품번호"
+// @Param        status query string false "주문 상태 (쉼표로 구분)"
+// @Param        from query string false "조회 시작일 (YYYY-MM-DD)"
+// @Param        to query string false "조회 종료일 (YYYY-MM-DD)"
+// @Param        sort query string false "정렬 순서 (latest, oldest, amount_desc, amount_asc)"
+// @Param        page query int false "페이지 번호 (기본 1)"
+// @Param        size query int false "페이지 크기 (기본 20, 최대 100)"
+// @Success      200 {object} response.OrderPageResponse "주문 목록"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "주문 검색 실패"
+// @Router       /admin/orders [get]
+func (oc *OrderController) SearchOrders(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	var req request.AdminOrderSearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	criteria, err := req.ToCriteria()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	responseData, err := oc.orderInteractor.SearchOrders(criteria)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "주문을 검색할 수 없습니다."})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// GetOrderByNumber godoc
+// @Summary      주문번호로 주문 조회
+// @Description  주문번호로 주문 상세 정보를 조회합니다. (관리자 전용)
+// @Tags         admin
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        order_number path string true "주문번호"
+// @Success      200 {object} response.OrderResponse "주문 상세"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "주문 조회 실패"
+// @Router       /admin/orders/{order_number} [get]
+func (oc *OrderController) GetOrderByNumber(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	orderNumber := c.Param("order_number")
+
+	responseData, err := oc.orderInteractor.GetOrderByNumber(orderNumber)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
 }
 
 // CancelOrder godoc
@@ -74,8 +283,10 @@ func (oc *OrderController) GetMyOrders(c *gin.Context) {
 // @Security     Bearer
 // @Accept       json
 // @Produce      json
+// @Param        Idempotency-Key header string false "멱등 키"
 // @Param        id path string true "기본키 (primary key)"
 // @Success      200 {object} map[string]string "취소 성공"
+// @Failure      409 {object} map[string]string "취소 정책에 의해 거절"
 // @Failure      500 {object} map[string]string "취소 실패"
 // @Router       /orders/{id}/cancel [put]
 func (oc *OrderController) CancelOrder(c *gin.Context) {
@@ -89,12 +300,132 @@ func (oc *OrderController) CancelOrder(c *gin.Context) {
 	memberNumber := c.GetString("member_number")
 
 	if err := oc.orderInteractor.CancelOrder(id, memberNumber); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondCancelError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "주문이 취소되었습니다."})
 }
 
+// AdminCancelOrder godoc
+// @Summary      관리자 주문 취소
+// @Description  취소 사유 코드와 상세 사유를 남기고 주문을 취소합니다. (관리자 전용)
+// @Tags         admin
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        Idempotency-Key header string false "멱등 키"
+// @Param        order_number path string true "주문번호"
+// @Param        cancelRequest body request.AdminCancelOrderRequest true "취소 사유 (customer_request, fraud, out_of_stock, undeliverable, payment_issue, other)"
+// @Success      200 {object} response.OrderResponse "취소 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "취소 실패"
+// @Router       /admin/orders/{order_number}/cancel [put]
+func (oc *OrderController) AdminCancelOrder(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	var req request.AdminCancelOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	orderNumber := c.Param("order_number")
+	adminNumber := c.GetString("member_number")
+
+	responseData, err := oc.orderInteractor.AdminCancelOrder(orderNumber, adminNumber, &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// PartialCancelOrder godoc
+// @Summary      주문 부분 취소
+// @Description  주문 상품의 일부 수량을 취소하고 취소 금액을 환불합니다.
+// @Tags         orders
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        Idempotency-Key header string false "멱등 키"
+// @Param        id path string true "기본키 (primary key)"
+// @Param        cancelRequest body request.PartialCancelOrderRequest true "취소할 상품과 수량"
+// @Success      200 {object} response.OrderResponse "부분 취소 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      409 {object} map[string]string "취소 정책에 의해 거절"
+// @Failure      500 {object} map[string]string "부분 취소 실패"
+// @Router       /orders/{id}/partial-cancel [put]
+func (oc *OrderController) PartialCancelOrder(c *gin.Context) {
+	orderParam := c.Param("id")
+	id, err := strconv.Atoi(orderParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 주문 ID입니다."})
+		return
+	}
+
+	var req request.PartialCancelOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	memberNumber := c.GetString("member_number")
+
+	responseData, err := oc.orderInteractor.PartialCancelOrder(id, memberNumber, &req)
+	if err != nil {
+		respondCancelError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// ChangeOrderStatus godoc
+// @Summary      주문 상태 변경
+// @Description  주문을 결제 완료, 배송 중, 배송 완료 상태로 변경합니다. 결제 대기 주문을 결제 완료나 배송 중으로 변경하면 승인된 결제를 매입합니다. (관리자 전용)
+// @Tags         orders
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "기본키 (primary key)"
+// @Param        statusRequest body request.UpdateOrderStatusRequest true "변경할 주문 상태 (paid, shipped, delivered)"
+// @Success      200 {object} response.OrderResponse "변경 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "변경 실패"
+// @Router       /orders/{id}/status [put]
+func (oc *OrderController) ChangeOrderStatus(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	orderParam := c.Param("id")
+	id, err := strconv.Atoi(orderParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 주문 ID입니다."})
+		return
+	}
+
+	var req request.UpdateOrderStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	responseData, err := oc.orderInteractor.ChangeOrderStatus(id, &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
 // GetMonthlyStats godoc
 // @Summary      주문 통계 조회
 // @Description  특정 월의 주문 통계를 조회합니다. (관리자 전용)
@@ -121,14 +452,20 @@ func (oc *OrderController) GetMonthlyStats(c *gin.Context) {
 		return
 	}
 
-	totalSales, totalCanceled, err := oc.orderInteractor.GetMonthlyStats(month)
+	stats, err := oc.orderInteractor.GetMonthlyStats(month)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "통계 정보를 가져올 수 없습니다."})
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{
-		"month":          month,
-		"total_sales":    totalSales,
-		"total_canceled": totalCanceled,
-	})
+	c.JSON(http.StatusOK, response.NewOrderStatsResponse(month, stats))
+}
+
+// respondCancelError 는 취소 정책에 의해 거절된 경우 거절 사유 코드를 함께 응답합니다.
+func respondCancelError(c *gin.Context, err error) {
+	var rejected *domain.CancellationRejectedError
+	if errors.As(err, &rejected) {
+		c.JSON(http.StatusConflict, gin.H{"error": rejected.Message, "reason": rejected.Reason})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 }
@@ -3,14 +3,18 @@ package controller_test
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/gateway"
 	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
 	"github.com/HongJungWan/commerce-system/internal/interfaces/controller"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/response"
 	"github.com/HongJungWan/commerce-system/internal/usecases"
 	"github.com/HongJungWan/commerce-system/test/fixtures"
 	"github.com/gin-gonic/gin"
@@ -23,7 +27,7 @@ func TestOrderController_CreateOrder_Failure_InvalidRequest(t *testing.T) {
 	orderRepo := repository.NewOrderRepository(db)
 	memberRepo := repository.NewMemberRepository(db)
 	productRepo := repository.NewProductRepository(db)
-	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
 	orderController := controller.NewOrderController(orderInteractor)
 
 	router := gin.Default()
@@ -33,7 +37,7 @@ func TestOrderController_CreateOrder_Failure_InvalidRequest(t *testing.T) {
 	})
 
 	invalidOrder := map[string]interface{}{
-		"quantity": "invalid", // 잘못된 타입의 값
+		"items": "invalid", // 잘못된 타입의 값
 	}
 	requestBody, _ := json.Marshal(invalidOrder)
 	req, _ := http.NewRequest("POST", "/orders", bytes.NewBuffer(requestBody))
@@ -47,24 +51,174 @@ func TestOrderController_CreateOrder_Failure_InvalidRequest(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, resp.Code)
 }
 
+func TestOrderController_QuoteOrder_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Pizza",
+		Price:         1000,
+		StockQuantity: 10,
+	})
+
+	router := gin.Default()
+	router.POST("/orders/quote", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		orderController.QuoteOrder(c)
+	})
+
+	body := []byte(`{"items":[{"product_number":"P12345","quantity":3}]}`)
+	req, _ := http.NewRequest("POST", "/orders/quote", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3000, response["total_amount"])
+}
+
 func TestOrderController_GetMyOrders_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	_ = orderRepo.Create(&domain.Order{
+		OrderNumber:  "O12345",
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345"}},
+	})
+	_ = orderRepo.Create(&domain.Order{
+		OrderNumber:  "O12346",
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12346"}},
+	})
+
+	router := gin.Default()
+	router.GET("/orders", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		orderController.GetMyOrders(c)
+	})
+
+	req, _ := http.NewRequest("GET", "/orders", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var orders []response.OrderResponse
+	err := json.Unmarshal(resp.Body.Bytes(), &orders)
+	assert.NoError(t, err)
+	assert.Len(t, orders, 2)
+}
+
+func TestOrderController_GetMyOrders_Success_NextCursorHeader(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	for i := 0; i < 3; i++ {
+		_ = orderRepo.Create(&domain.Order{
+			OrderNumber:  fmt.Sprintf("O1234%d", i),
+			MemberNumber: "M12345",
+			Items:        []domain.OrderItem{{ProductNumber: "P12345"}},
+		})
+	}
+
+	router := gin.Default()
+	router.GET("/orders", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		orderController.GetMyOrders(c)
+	})
+
+	firstReq, _ := http.NewRequest("GET", "/orders?limit=2", nil)
+	firstResp := httptest.NewRecorder()
+	router.ServeHTTP(firstResp, firstReq)
+	nextCursor := firstResp.Header().Get("X-Next-Cursor")
+
+	// When
+	secondReq, _ := http.NewRequest("GET", "/orders?limit=2&cursor="+nextCursor, nil)
+	secondResp := httptest.NewRecorder()
+	router.ServeHTTP(secondResp, secondReq)
+
+	// Then
+	var firstOrders, secondOrders []response.OrderResponse
+	_ = json.Unmarshal(firstResp.Body.Bytes(), &firstOrders)
+	err := json.Unmarshal(secondResp.Body.Bytes(), &secondOrders)
+	assert.NoError(t, err)
+	assert.Len(t, firstOrders, 2)
+	assert.NotEmpty(t, nextCursor)
+	assert.Equal(t, http.StatusOK, secondResp.Code)
+	assert.Len(t, secondOrders, 1)
+	assert.Empty(t, secondResp.Header().Get("X-Next-Cursor"))
+}
+
+func TestOrderController_GetMyOrders_Failure_LimitTooLarge(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	router := gin.Default()
+	router.GET("/orders", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		orderController.GetMyOrders(c)
+	})
+
+	req, _ := http.NewRequest("GET", "/orders?limit=101", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestOrderController_GetMyOrdersV2_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	orderRepo := repository.NewOrderRepository(db)
 	memberRepo := repository.NewMemberRepository(db)
 	productRepo := repository.NewProductRepository(db)
-	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
 	orderController := controller.NewOrderController(orderInteractor)
 
 	order1 := &domain.Order{
-		OrderNumber:   "O12345",
-		MemberNumber:  "M12345",
-		ProductNumber: "P12345",
+		OrderNumber:  "O12345",
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345"}},
 	}
 	order2 := &domain.Order{
-		OrderNumber:   "O12346",
-		MemberNumber:  "M12345",
-		ProductNumber: "P12346",
+		OrderNumber:  "O12346",
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12346"}},
 	}
 	_ = orderRepo.Create(order1)
 	_ = orderRepo.Create(order2)
@@ -72,7 +226,7 @@ func TestOrderController_GetMyOrders_Success(t *testing.T) {
 	router := gin.Default()
 	router.GET("/orders", func(c *gin.Context) {
 		c.Set("member_number", "M12345")
-		orderController.GetMyOrders(c)
+		orderController.GetMyOrdersV2(c)
 	})
 
 	req, _ := http.NewRequest("GET", "/orders", nil)
@@ -83,10 +237,166 @@ func TestOrderController_GetMyOrders_Success(t *testing.T) {
 
 	// Then
 	assert.Equal(t, http.StatusOK, resp.Code)
-	var orders []domain.Order
+	var orders response.OrderListResponse
 	err := json.Unmarshal(resp.Body.Bytes(), &orders)
 	assert.NoError(t, err)
-	assert.Len(t, orders, 2)
+	assert.Len(t, orders.Orders, 2)
+	assert.False(t, orders.HasNext)
+}
+
+func TestOrderController_GetMyOrdersV2_Failure_InvalidStatus(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderInteractor := usecases.NewOrderInteractor(repository.NewOrderRepository(db), repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	router := gin.Default()
+	router.GET("/orders", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		orderController.GetMyOrdersV2(c)
+	})
+
+	req, _ := http.NewRequest("GET", "/orders?status=unknown", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestOrderController_GetOrder_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	order := &domain.Order{
+		OrderNumber:  "O12345",
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345"}},
+	}
+	_ = orderRepo.Create(order)
+
+	router := gin.Default()
+	router.GET("/orders/:id", func(c *gin.Context) {
+		c.Set("member_number", "M99999")
+		c.Set("is_admin", true)
+		orderController.GetOrder(c)
+	})
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/orders/%d", order.ID), nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var orderResponse response.OrderResponse
+	err := json.Unmarshal(resp.Body.Bytes(), &orderResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, "O12345", orderResponse.OrderNumber)
+}
+
+func TestOrderController_SearchOrders_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	_ = orderRepo.Create(&domain.Order{
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345"}},
+		Status:       domain.OrderStatusPaid,
+	})
+	_ = orderRepo.Create(&domain.Order{
+		OrderNumber:  "O12346",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12346",
+		Items:        []domain.OrderItem{{ProductNumber: "P12346"}},
+		Status:       domain.OrderStatusPaid,
+	})
+
+	router := gin.Default()
+	router.GET("/admin/orders", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		orderController.SearchOrders(c)
+	})
+
+	req, _ := http.NewRequest("GET", "/admin/orders?member_number=M12346&status=paid", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var page response.OrderPageResponse
+	err := json.Unmarshal(resp.Body.Bytes(), &page)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, page.TotalCount)
+	assert.Equal(t, "O12346", page.Orders[0].OrderNumber)
+}
+
+func TestOrderController_SearchOrders_Failure_Forbidden(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderInteractor := usecases.NewOrderInteractor(repository.NewOrderRepository(db), repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	router := gin.Default()
+	router.GET("/admin/orders", func(c *gin.Context) {
+		c.Set("is_admin", false)
+		orderController.SearchOrders(c)
+	})
+
+	req, _ := http.NewRequest("GET", "/admin/orders", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
+
+func TestOrderController_GetOrderByNumber_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	_ = orderRepo.Create(&domain.Order{
+		OrderNumber:  "O12345",
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345"}},
+	})
+
+	router := gin.Default()
+	router.GET("/admin/orders/:order_number", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		orderController.GetOrderByNumber(c)
+	})
+
+	req, _ := http.NewRequest("GET", "/admin/orders/O12345", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var orderResponse response.OrderResponse
+	err := json.Unmarshal(resp.Body.Bytes(), &orderResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, "M12345", orderResponse.MemberNumber)
 }
 
 func TestOrderController_CancelOrder_Success(t *testing.T) {
@@ -95,7 +405,7 @@ func TestOrderController_CancelOrder_Success(t *testing.T) {
 	orderRepo := repository.NewOrderRepository(db)
 	memberRepo := repository.NewMemberRepository(db)
 	productRepo := repository.NewProductRepository(db)
-	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
 	orderController := controller.NewOrderController(orderInteractor)
 
 	product := &domain.Product{
@@ -104,12 +414,11 @@ func TestOrderController_CancelOrder_Success(t *testing.T) {
 		StockQuantity: 10,
 	}
 	order := &domain.Order{
-		ID:            12345,
-		OrderNumber:   "O12345",
-		MemberNumber:  "M12345",
-		ProductNumber: "P12345",
-		Quantity:      2,
-		IsCanceled:    false,
+		ID:           12345,
+		OrderNumber:  "O12345",
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Quantity: 2}},
+		Status:       domain.OrderStatusPending,
 	}
 	_ = productRepo.Create(product)
 	_ = orderRepo.Create(order)
@@ -134,36 +443,254 @@ func TestOrderController_CancelOrder_Success(t *testing.T) {
 	assert.Equal(t, "주문이 취소되었습니다.", response["message"])
 }
 
-func TestOrderController_GetMonthlyStats_Success(t *testing.T) {
+func TestOrderController_AdminCancelOrder_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		StockQuantity: 10,
+	})
+	_ = orderRepo.Create(&domain.Order{
+		OrderNumber:  "O12345",
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Quantity: 2}},
+		Status:       domain.OrderStatusPending,
+	})
+
+	router := gin.Default()
+	router.PUT("/admin/orders/:order_number/cancel", func(c *gin.Context) {
+		c.Set("member_number", "ADMIN1")
+		c.Set("is_admin", true)
+		orderController.AdminCancelOrder(c)
+	})
+
+	body, _ := json.Marshal(request.AdminCancelOrderRequest{ReasonCode: "fraud", Note: "도용 의심"})
+	req, _ := http.NewRequest("PUT", "/admin/orders/O12345/cancel", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var orderResponse response.OrderResponse
+	err := json.Unmarshal(resp.Body.Bytes(), &orderResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, "canceled", orderResponse.Status)
+	assert.Equal(t, "fraud", orderResponse.CancelReason)
+	assert.Equal(t, "도용 의심", orderResponse.CancelNote)
+}
+
+func TestOrderController_AdminCancelOrder_Failure_Forbidden(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderInteractor := usecases.NewOrderInteractor(repository.NewOrderRepository(db), repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	router := gin.Default()
+	router.PUT("/admin/orders/:order_number/cancel", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		c.Set("is_admin", false)
+		orderController.AdminCancelOrder(c)
+	})
+
+	body, _ := json.Marshal(request.AdminCancelOrderRequest{ReasonCode: "fraud", Note: "도용 의심"})
+	req, _ := http.NewRequest("PUT", "/admin/orders/O12345/cancel", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
+
+func TestOrderController_CancelOrder_Failure_CancellationPolicy(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	policy := &domain.CancellationPolicy{MaxHoursAfterOrder: 24}
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), policy, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now().Add(-48 * time.Hour),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Quantity: 2}},
+		Status:       domain.OrderStatusPending,
+	}
+	_ = orderRepo.Create(order)
+
+	router := gin.Default()
+	router.PUT("/orders/:id/cancel", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		orderController.CancelOrder(c)
+	})
+
+	req, _ := http.NewRequest("PUT", "/orders/12345/cancel", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusConflict, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "window_expired", response["reason"])
+	assert.Equal(t, "주문 후 24시간이 지나 취소할 수 없습니다.", response["error"])
+}
+
+func TestOrderController_PartialCancelOrder_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	orderRepo := repository.NewOrderRepository(db)
 	memberRepo := repository.NewMemberRepository(db)
 	productRepo := repository.NewProductRepository(db)
-	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
 	orderController := controller.NewOrderController(orderInteractor)
 
-	order1 := &domain.Order{
+	product := &domain.Product{
 		ID:            12345,
-		OrderNumber:   "O12345",
-		OrderDate:     time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC),
-		MemberNumber:  "M12345",
 		ProductNumber: "P12345",
-		Price:         1000,
-		Quantity:      2,
-		TotalAmount:   2000,
-		IsCanceled:    false,
+		StockQuantity: 10,
+	}
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPaid,
+	}
+	_ = productRepo.Create(product)
+	_ = orderRepo.Create(order)
+
+	router := gin.Default()
+	router.PUT("/orders/:id/partial-cancel", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		orderController.PartialCancelOrder(c)
+	})
+
+	body := []byte(`{"product_number":"P12345","quantity":1}`)
+	req, _ := http.NewRequest("PUT", "/orders/12345/partial-cancel", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1000, response["canceled_amount"])
+}
+
+func TestOrderController_ChangeOrderStatus_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Quantity: 2}},
+		Status:       domain.OrderStatusPending,
+	}
+	_ = orderRepo.Create(order)
+
+	router := gin.Default()
+	router.PUT("/orders/:id/status", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		orderController.ChangeOrderStatus(c)
+	})
+
+	requestBody, _ := json.Marshal(map[string]interface{}{"status": "paid"})
+	req, _ := http.NewRequest("PUT", "/orders/12345/status", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "paid", response["status"])
+}
+
+func TestOrderController_ChangeOrderStatus_Failure_Unauthorized(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	router := gin.Default()
+	router.PUT("/orders/:id/status", func(c *gin.Context) {
+		c.Set("is_admin", false)
+		orderController.ChangeOrderStatus(c)
+	})
+
+	requestBody, _ := json.Marshal(map[string]interface{}{"status": "paid"})
+	req, _ := http.NewRequest("PUT", "/orders/12345/status", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
+
+func TestOrderController_GetMonthlyStats_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	orderController := controller.NewOrderController(orderInteractor)
+
+	order1 := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPending,
 	}
 	order2 := &domain.Order{
-		ID:            12346,
-		OrderNumber:   "O12346",
-		OrderDate:     time.Date(2024, 9, 15, 0, 0, 0, 0, time.UTC),
-		MemberNumber:  "M12346",
-		ProductNumber: "P12346",
-		Price:         1500,
-		Quantity:      1,
-		TotalAmount:   1500,
-		IsCanceled:    true,
+		ID:           12346,
+		OrderNumber:  "O12346",
+		OrderDate:    time.Date(2024, 9, 15, 0, 0, 0, 0, time.UTC),
+		MemberNumber: "M12346",
+		Items:        []domain.OrderItem{{ProductNumber: "P12346", Price: 1500, Quantity: 1, LineTotal: 1500}},
+		TotalAmount:  1500,
+		Status:       domain.OrderStatusCanceled,
 	}
 	_ = orderRepo.Create(order1)
 	_ = orderRepo.Create(order2)
@@ -196,7 +723,7 @@ func TestOrderController_GetMonthlyStats_Failure_Unauthorized(t *testing.T) {
 	orderRepo := repository.NewOrderRepository(db)
 	memberRepo := repository.NewMemberRepository(db)
 	productRepo := repository.NewProductRepository(db)
-	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
 	orderController := controller.NewOrderController(orderInteractor)
 
 	router := gin.Default()
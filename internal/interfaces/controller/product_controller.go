@@ -1,9 +1,11 @@
 package controller
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
+	"github.com/HongJungWan/commerce-system/internal/domain"
 	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
 	"github.com/HongJungWan/commerce-system/internal/usecases"
 	"github.com/gin-gonic/gin"
@@ -19,30 +21,41 @@ func NewProductController(pi *usecases.ProductInteractor) *ProductController {
 
 // GetProducts godoc
 // @Summary      상품 목록 조회
-// @Description  상품 목록을 필터링하여 조회합니다.
+// @Description  상품을 검색어, 카테고리, 가격, 재고 조건으로 검색하고 커서 기반으로 페이지 단위 조회합니다.
 // @Tags         products
 // @Accept       json
 // @Produce      json
-// @Param        category query string false "카테고리"
-// @Param        product_name query string false "상품명"
-// @Success      200 {array} response.ProductResponse "상품 목록"
+// @Param        keyword query string false "검색어 (상품명, 상품 설명)"
+// @Param        category query string false "카테고리 슬러그 (쉼표로 구분, 하위 카테고리 포함)"
+// @Param        min_price query int false "최소 가격"
+// @Param        max_price query int false "최대 가격"
+// @Param        in_stock query bool false "재고가 있는 상품만 조회"
+// @Param        sort query string false "정렬 순서 (newest, price_asc, price_desc, relevance / 기본: 검색어가 있으면 relevance, 없으면 newest)"
+// @Param        cursor query string false "이전 페이지의 next_cursor"
+// @Param        limit query int false "페이지 크기 (기본 20, 최대 100)"
+// @Success      200 {object} response.ProductListResponse "상품 목록"
+// @Failure      400 {object} map[string]string "잘못된 요청"
 // @Failure      500 {object} map[string]string "조회 실패"
 // @Router       /products [get]
 func (pc *ProductController) GetProducts(c *gin.Context) {
-	filter := make(map[string]interface{})
-	if category := c.Query("category"); category != "" {
-		filter["category"] = category
+	var req request.ProductSearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
 	}
-	if name := c.Query("product_name"); name != "" {
-		filter["product_name"] = name
+
+	query, err := req.ToQuery()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
 	}
 
-	productResponses, err := pc.productInteractor.GetProducts(filter)
+	responseData, err := pc.productInteractor.GetProducts(query)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "상품 목록을 가져올 수 없습니다."})
 		return
 	}
-	c.JSON(http.StatusOK, productResponses)
+	c.JSON(http.StatusOK, responseData)
 }
 
 // CreateProduct godoc
@@ -71,7 +84,53 @@ func (pc *ProductController) CreateProduct(c *gin.Context) {
 		return
 	}
 
-	responseData, err := pc.productInteractor.CreateProduct(&req)
+	adminNumber := c.GetString("member_number")
+
+	responseData, err := pc.productInteractor.CreateProduct(&req, adminNumber)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, responseData)
+}
+
+// AddVariant godoc
+// @Summary      옵션 상품 추가
+// @Description  옵션이 정의된 상위 상품에 옵션 값 조합별 옵션 상품을 추가합니다. 가격을 지정하지 않으면 상위 상품 가격을 사용합니다. (관리자 전용)
+// @Tags         products
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "상위 상품 기본키 (primary key)"
+// @Param        variantRequest body request.AddVariantRequest true "옵션 상품 정보"
+// @Success      201 {object} response.ProductResponse "추가 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "추가 실패"
+// @Router       /products/{id}/variants [post]
+func (pc *ProductController) AddVariant(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 상품 ID입니다."})
+		return
+	}
+
+	var req request.AddVariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	adminNumber := c.GetString("member_number")
+
+	responseData, err := pc.productInteractor.AddVariant(id, &req, adminNumber)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -79,6 +138,85 @@ func (pc *ProductController) CreateProduct(c *gin.Context) {
 	c.JSON(http.StatusCreated, responseData)
 }
 
+// UpdateProduct godoc
+// @Summary      상품 정보 수정
+// @Description  상품명, 카테고리, 설명, 가격, 무게와 크기 중 지정한 항목만 수정합니다. 가격이 바뀌면 가격 변경 이력이 기록됩니다. (관리자 전용)
+// @Tags         products
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Param        updateRequest body request.UpdateProductRequest true "수정할 항목"
+// @Success      200 {object} response.ProductResponse "수정 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "수정 실패"
+// @Router       /products/{id} [patch]
+func (pc *ProductController) UpdateProduct(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 상품 ID입니다."})
+		return
+	}
+
+	var req request.UpdateProductRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	adminNumber := c.GetString("member_number")
+
+	responseData, err := pc.productInteractor.UpdateProduct(id, &req, adminNumber)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// GetPriceHistory godoc
+// @Summary      가격 변경 이력 조회
+// @Description  상품의 가격 변경 이력을 오래된 순으로 조회합니다. (관리자 전용)
+// @Tags         products
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Success      200 {array} response.ProductPriceChangeResponse "가격 변경 이력"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "조회 실패"
+// @Router       /products/{id}/price-history [get]
+func (pc *ProductController) GetPriceHistory(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 상품 ID입니다."})
+		return
+	}
+
+	responseData, err := pc.productInteractor.GetPriceHistory(id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
 // UpdateStock godoc
 // @Summary      재고 수정
 // @Description  상품의 재고 수량을 수정합니다. (관리자 전용)
@@ -91,6 +229,7 @@ func (pc *ProductController) CreateProduct(c *gin.Context) {
 // @Success      200 {object} map[string]string "수정 성공"
 // @Failure      400 {object} map[string]string "잘못된 요청"
 // @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      409 {object} map[string]string "재고 수량 충돌"
 // @Failure      500 {object} map[string]string "수정 실패"
 // @Router       /products/{id}/stock [put]
 func (pc *ProductController) UpdateStock(c *gin.Context) {
@@ -113,16 +252,168 @@ func (pc *ProductController) UpdateStock(c *gin.Context) {
 		return
 	}
 
-	if err := pc.productInteractor.UpdateStock(id, req.StockQuantity); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	adminNumber := c.GetString("member_number")
+
+	if err := pc.productInteractor.UpdateStock(id, &req, adminNumber); err != nil {
+		respondStockError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "재고 수량이 수정되었습니다."})
 }
 
+// AdjustStock godoc
+// @Summary      재고 증감 조정
+// @Description  상품 재고를 현재 수량 기준으로 증감합니다. 결과 재고가 음수가 되면 거절됩니다. (관리자 전용)
+// @Tags         products
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Param        adjustRequest body request.AdjustStockRequest true "증감 수량과 사유"
+// @Success      200 {object} response.StockMovementResponse "조정 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      409 {object} map[string]string "재고 부족"
+// @Failure      500 {object} map[string]string "조정 실패"
+// @Router       /products/{id}/stock/adjust [post]
+func (pc *ProductController) AdjustStock(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 상품 ID입니다."})
+		return
+	}
+
+	var req request.AdjustStockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	adminNumber := c.GetString("member_number")
+
+	responseData, err := pc.productInteractor.AdjustStock(id, &req, adminNumber)
+	if err != nil {
+		respondStockError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// GetStockMovements godoc
+// @Summary      재고 변동 이력 조회
+// @Description  상품의 재고 변동 이력을 오래된 순으로 조회합니다. (관리자 전용)
+// @Tags         products
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Success      200 {array} response.StockMovementResponse "재고 변동 이력"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "조회 실패"
+// @Router       /products/{id}/stock-movements [get]
+func (pc *ProductController) GetStockMovements(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 상품 ID입니다."})
+		return
+	}
+
+	responseData, err := pc.productInteractor.GetStockMovements(id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// ArchiveProduct godoc
+// @Summary      상품 보관 처리
+// @Description  상품을 판매 중단 상태로 보관합니다. 목록과 주문 대상에서 제외되며, 지난 주문에서는 계속 조회됩니다. (관리자 전용)
+// @Tags         products
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Success      200 {object} response.ProductResponse "보관 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "보관 실패"
+// @Router       /products/{id}/archive [post]
+func (pc *ProductController) ArchiveProduct(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 상품 ID입니다."})
+		return
+	}
+
+	responseData, err := pc.productInteractor.ArchiveProduct(id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// RestoreProduct godoc
+// @Summary      상품 보관 해제
+// @Description  보관 처리된 상품을 다시 판매 상태로 되돌립니다. (관리자 전용)
+// @Tags         products
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Success      200 {object} response.ProductResponse "해제 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "해제 실패"
+// @Router       /products/{id}/restore [post]
+func (pc *ProductController) RestoreProduct(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 상품 ID입니다."})
+		return
+	}
+
+	responseData, err := pc.productInteractor.RestoreProduct(id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
 // DeleteProduct godoc
 // @Summary      상품 삭제
-// @Description  상품을 삭제합니다. (관리자 전용)
+// @Description  주문 이력이 없는 상품을 삭제합니다. 주문 이력이 있으면 보관 처리를 이용해야 합니다. (관리자 전용)
 // @Tags         products
 // @Security     Bearer
 // @Accept       json
@@ -153,3 +444,12 @@ func (pc *ProductController) DeleteProduct(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "상품이 삭제되었습니다."})
 }
+
+// respondStockError 는 재고 부족이나 동시 수정으로 반영되지 않은 경우 409로 응답합니다.
+func respondStockError(c *gin.Context, err error) {
+	if errors.Is(err, domain.ErrInsufficientStock) || errors.Is(err, domain.ErrStockConflict) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/HongJungWan/commerce-system/internal/domain"
 	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
 	"github.com/HongJungWan/commerce-system/internal/interfaces/controller"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/response"
 	"github.com/HongJungWan/commerce-system/internal/usecases"
 	"github.com/HongJungWan/commerce-system/test/fixtures"
 	"github.com/gin-gonic/gin"
@@ -20,7 +22,7 @@ func TestProductController_GetProducts_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	productRepo := repository.NewProductRepository(db)
-	productInteractor := usecases.NewProductInteractor(productRepo, db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
 	productController := controller.NewProductController(productInteractor)
 
 	product1 := &domain.Product{
@@ -53,18 +55,83 @@ func TestProductController_GetProducts_Success(t *testing.T) {
 
 	// Then
 	assert.Equal(t, http.StatusOK, resp.Code)
-	var products []domain.Product
+	var products response.ProductListResponse
 	err := json.Unmarshal(resp.Body.Bytes(), &products)
 	assert.NoError(t, err)
-	assert.Len(t, products, 1)
-	assert.Equal(t, "Product One", products[0].ProductName)
+	assert.Len(t, products.Products, 1)
+	assert.EqualValues(t, 1, products.TotalCount)
+	assert.False(t, products.HasNext)
+	assert.Equal(t, "Product One", products.Products[0].ProductName)
+}
+
+func TestProductController_GetProducts_Success_NextCursor(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	productController := controller.NewProductController(productInteractor)
+
+	_ = productRepo.Create(&domain.Product{ProductNumber: "P1", ProductName: "Cheap", Price: 1000, StockQuantity: 1})
+	_ = productRepo.Create(&domain.Product{ProductNumber: "P2", ProductName: "Expensive", Price: 5000, StockQuantity: 1})
+	_ = productRepo.Create(&domain.Product{ProductNumber: "P3", ProductName: "Sold Out", Price: 3000})
+
+	router := gin.Default()
+	router.GET("/products", productController.GetProducts)
+
+	firstReq, _ := http.NewRequest("GET", "/products?sort=price_asc&in_stock=true&limit=1", nil)
+	firstResp := httptest.NewRecorder()
+	router.ServeHTTP(firstResp, firstReq)
+	var firstPage response.ProductListResponse
+	_ = json.Unmarshal(firstResp.Body.Bytes(), &firstPage)
+
+	req, _ := http.NewRequest("GET", "/products?sort=price_asc&in_stock=true&limit=1&cursor="+firstPage.NextCursor, nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var products response.ProductListResponse
+	err := json.Unmarshal(resp.Body.Bytes(), &products)
+	assert.NoError(t, err)
+	assert.Equal(t, "Cheap", firstPage.Products[0].ProductName)
+	assert.True(t, firstPage.HasNext)
+	assert.Len(t, products.Products, 1)
+	assert.Equal(t, "Expensive", products.Products[0].ProductName)
+	assert.EqualValues(t, 2, products.TotalCount)
+	assert.False(t, products.HasNext)
+}
+
+func TestProductController_GetProducts_Failure_InvalidSort(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	productController := controller.NewProductController(productInteractor)
+
+	router := gin.Default()
+	router.GET("/products", productController.GetProducts)
+
+	req, _ := http.NewRequest("GET", "/products?sort=popular", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "유효하지 않은 정렬 순서입니다.", response["error"])
 }
 
 func TestProductController_CreateProduct_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	productRepo := repository.NewProductRepository(db)
-	productInteractor := usecases.NewProductInteractor(productRepo, db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
 	productController := controller.NewProductController(productInteractor)
 
 	router := gin.Default()
@@ -100,7 +167,7 @@ func TestProductController_CreateProduct_Failure_Unauthorized(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	productRepo := repository.NewProductRepository(db)
-	productInteractor := usecases.NewProductInteractor(productRepo, db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
 	productController := controller.NewProductController(productInteractor)
 
 	router := gin.Default()
@@ -128,11 +195,132 @@ func TestProductController_CreateProduct_Failure_Unauthorized(t *testing.T) {
 	assert.Equal(t, http.StatusForbidden, resp.Code)
 }
 
+func TestProductController_AddVariant_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	productController := controller.NewProductController(productInteractor)
+
+	parent := &domain.Product{
+		ID:            12345,
+		ProductNumber: "P12345",
+		ProductName:   "T-Shirt",
+		Price:         10000,
+		Options:       []domain.ProductOption{{Name: "size", Values: []string{"S", "M"}}},
+	}
+	_ = productRepo.Create(parent)
+
+	router := gin.Default()
+	router.POST("/products/:id/variants", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		c.Set("member_number", "ADMIN1")
+		productController.AddVariant(c)
+	})
+
+	variantData := map[string]interface{}{
+		"sku":            "TSHIRT-S",
+		"option_values":  map[string]string{"size": "S"},
+		"price":          11000,
+		"stock_quantity": 7,
+	}
+	requestBody, _ := json.Marshal(variantData)
+	req, _ := http.NewRequest("POST", "/products/12345/variants", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "TSHIRT-S", response["sku"])
+	assert.Equal(t, "P12345", response["parent_product_number"])
+	assert.Equal(t, float64(11000), response["price"])
+	assert.Equal(t, float64(7), response["stock_quantity"])
+}
+
+func TestProductController_UpdateProduct_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	productController := controller.NewProductController(productInteractor)
+
+	product := &domain.Product{
+		ID:            12345,
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	router := gin.Default()
+	router.PATCH("/products/:id", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		c.Set("member_number", "ADMIN1")
+		productController.UpdateProduct(c)
+	})
+
+	updateData := map[string]interface{}{
+		"price":       1500,
+		"description": "updated description",
+	}
+	requestBody, _ := json.Marshal(updateData)
+	req, _ := http.NewRequest("PATCH", "/products/12345", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1500), response["price"])
+	assert.Equal(t, "updated description", response["description"])
+	assert.Equal(t, "Test Product", response["product_name"])
+
+	changes, _ := productRepo.GetPriceChanges("P12345")
+	assert.Len(t, changes, 1)
+}
+
+func TestProductController_UpdateProduct_Failure_Unauthorized(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	productController := controller.NewProductController(productInteractor)
+
+	router := gin.Default()
+	router.PATCH("/products/:id", func(c *gin.Context) {
+		c.Set("is_admin", false)
+		productController.UpdateProduct(c)
+	})
+
+	requestBody, _ := json.Marshal(map[string]interface{}{"price": 1500})
+	req, _ := http.NewRequest("PATCH", "/products/12345", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
+
 func TestProductController_UpdateStock_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	productRepo := repository.NewProductRepository(db)
-	productInteractor := usecases.NewProductInteractor(productRepo, db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
 	productController := controller.NewProductController(productInteractor)
 
 	product := &domain.Product{
@@ -172,11 +360,175 @@ func TestProductController_UpdateStock_Success(t *testing.T) {
 	assert.Equal(t, 20, updatedProduct.StockQuantity)
 }
 
+func TestProductController_AdjustStock_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	productController := controller.NewProductController(productInteractor)
+
+	product := &domain.Product{
+		ID:            12345,
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	router := gin.Default()
+	router.POST("/products/:id/stock/adjust", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		c.Set("member_number", "ADMIN1")
+		productController.AdjustStock(c)
+	})
+
+	adjustData := map[string]interface{}{
+		"delta":  -4,
+		"reason": "파손 상품 폐기",
+	}
+	requestBody, _ := json.Marshal(adjustData)
+	req, _ := http.NewRequest("POST", "/products/12345/stock/adjust", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(6), response["balance"])
+	assert.Equal(t, "파손 상품 폐기", response["reason"])
+}
+
+func TestProductController_AdjustStock_Failure_InsufficientStock(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	productController := controller.NewProductController(productInteractor)
+
+	product := &domain.Product{
+		ID:            12345,
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 3,
+	}
+	_ = productRepo.Create(product)
+
+	router := gin.Default()
+	router.POST("/products/:id/stock/adjust", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		c.Set("member_number", "ADMIN1")
+		productController.AdjustStock(c)
+	})
+
+	adjustData := map[string]interface{}{
+		"delta":  -4,
+		"reason": "재고 실사",
+	}
+	requestBody, _ := json.Marshal(adjustData)
+	req, _ := http.NewRequest("POST", "/products/12345/stock/adjust", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusConflict, resp.Code)
+	unchangedProduct, _ := productRepo.GetById(12345)
+	assert.Equal(t, 3, unchangedProduct.StockQuantity)
+}
+
+func TestProductController_GetStockMovements_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	productController := controller.NewProductController(productInteractor)
+
+	product := &domain.Product{
+		ID:            12345,
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+	_ = productInteractor.UpdateStock(12345, &request.UpdateStockRequest{StockQuantity: 15}, "ADMIN1")
+
+	router := gin.Default()
+	router.GET("/products/:id/stock-movements", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		productController.GetStockMovements(c)
+	})
+
+	req, _ := http.NewRequest("GET", "/products/12345/stock-movements", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var movements []map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &movements)
+	assert.NoError(t, err)
+	assert.Len(t, movements, 1)
+	assert.Equal(t, "adjustment", movements[0]["type"])
+	assert.EqualValues(t, 5, movements[0]["delta"])
+	assert.EqualValues(t, 15, movements[0]["balance"])
+}
+
+func TestProductController_ArchiveProduct_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	productController := controller.NewProductController(productInteractor)
+
+	product := &domain.Product{
+		ID:            12345,
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	router := gin.Default()
+	router.POST("/products/:id/archive", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		productController.ArchiveProduct(c)
+	})
+
+	req, _ := http.NewRequest("POST", "/products/12345/archive", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, response["archived_at"])
+
+	archivedProduct, _ := productRepo.GetById(12345)
+	assert.True(t, archivedProduct.IsArchived())
+}
+
 func TestProductController_DeleteProduct_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	productRepo := repository.NewProductRepository(db)
-	productInteractor := usecases.NewProductInteractor(productRepo, db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
 	productController := controller.NewProductController(productInteractor)
 
 	product := &domain.Product{
@@ -217,7 +569,7 @@ func TestProductController_DeleteProduct_Failure_HasOrders(t *testing.T) {
 	db := fixtures.SetupTestDB()
 	productRepo := repository.NewProductRepository(db)
 	orderRepo := repository.NewOrderRepository(db)
-	productInteractor := usecases.NewProductInteractor(productRepo, db)
+	productInteractor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
 	productController := controller.NewProductController(productInteractor)
 
 	product := &domain.Product{
@@ -229,7 +581,7 @@ func TestProductController_DeleteProduct_Failure_HasOrders(t *testing.T) {
 	}
 	order := &domain.Order{
 		OrderNumber: "O12345",
-		ID:          12345,
+		Items:       []domain.OrderItem{{ProductNumber: "P12345"}},
 	}
 	_ = productRepo.Create(product)
 	_ = orderRepo.Create(order)
@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/gin-gonic/gin"
+)
+
+type ReturnController struct {
+	returnInteractor *usecases.ReturnInteractor
+}
+
+func NewReturnController(ri *usecases.ReturnInteractor) *ReturnController {
+	return &ReturnController{returnInteractor: ri}
+}
+
+// RequestReturn godoc
+// @Summary      반품 요청
+// @Description  배송 완료된 주문의 상품 반품을 요청합니다.
+// @Tags         returns
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        Idempotency-Key header string false "멱등 키"
+// @Param        id path string true "주문 기본키 (primary key)"
+// @Param        returnRequest body request.CreateReturnRequest true "반품 정보"
+// @Success      201 {object} response.ReturnResponse "반품 요청 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      500 {object} map[string]string "반품 요청 실패"
+// @Router       /orders/{id}/returns [post]
+func (rc *ReturnController) RequestReturn(c *gin.Context) {
+	orderParam := c.Param("id")
+	orderId, err := strconv.Atoi(orderParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 주문 ID입니다."})
+		return
+	}
+
+	var req request.CreateReturnRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	memberNumber := c.GetString("member_number")
+
+	responseData, err := rc.returnInteractor.RequestReturn(orderId, memberNumber, &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, responseData)
+}
+
+// GetMyReturns godoc
+// @Summary      내 반품 조회
+// @Description  인증된 사용자의 반품 요청 목록을 조회합니다.
+// @Tags         returns
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Success      200 {array} response.ReturnResponse "반품 목록"
+// @Failure      500 {object} map[string]string "반품 조회 실패"
+// @Router       /returns/me [get]
+func (rc *ReturnController) GetMyReturns(c *gin.Context) {
+	memberNumber := c.GetString("member_number")
+
+	responseData, err := rc.returnInteractor.GetMyReturns(memberNumber)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "반품 목록을 가져올 수 없습니다."})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// GetReturns godoc
+// @Summary      반품 목록 조회
+// @Description  전체 반품 요청 목록을 조회합니다. (관리자 전용)
+// @Tags         returns
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        status query string false "반품 상태 (requested, approved, received, refunded, rejected)"
+// @Success      200 {array} response.ReturnResponse "반품 목록"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "반품 조회 실패"
+// @Router       /returns [get]
+func (rc *ReturnController) GetReturns(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	responseData, err := rc.returnInteractor.GetReturns(c.Query("status"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// ChangeReturnStatus godoc
+// @Summary      반품 상태 변경
+// @Description  반품을 승인, 거절, 입고, 환불 상태로 변경합니다. (관리자 전용)
+// @Tags         returns
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "반품 기본키 (primary key)"
+// @Param        statusRequest body request.UpdateReturnStatusRequest true "변경할 반품 상태 (approved, rejected, received, refunded)"
+// @Success      200 {object} response.ReturnResponse "변경 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "변경 실패"
+// @Router       /returns/{id}/status [put]
+func (rc *ReturnController) ChangeReturnStatus(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	returnParam := c.Param("id")
+	returnId, err := strconv.Atoi(returnParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 반품 ID입니다."})
+		return
+	}
+
+	var req request.UpdateReturnStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	adminNumber := c.GetString("member_number")
+
+	responseData, err := rc.returnInteractor.ChangeReturnStatus(returnId, adminNumber, &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
@@ -0,0 +1,153 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/gateway"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/controller"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReturnController_RequestReturn_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	returnRepo := repository.NewReturnRequestRepository(db)
+	orderRepo := repository.NewOrderRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	returnInteractor := usecases.NewReturnInteractor(returnRepo, orderRepo, productRepo, gateway.NewFakePaymentGateway(), db)
+	returnController := controller.NewReturnController(returnInteractor)
+
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusDelivered,
+	}
+	_ = orderRepo.Create(order)
+
+	router := gin.Default()
+	router.POST("/orders/:id/returns", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		returnController.RequestReturn(c)
+	})
+
+	body := []byte(`{"product_number":"P12345","quantity":1,"reason":"파손"}`)
+	req, _ := http.NewRequest("POST", "/orders/12345/returns", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "requested", response["status"])
+}
+
+func TestReturnController_GetMyReturns_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	returnRepo := repository.NewReturnRequestRepository(db)
+	orderRepo := repository.NewOrderRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	returnInteractor := usecases.NewReturnInteractor(returnRepo, orderRepo, productRepo, gateway.NewFakePaymentGateway(), db)
+	returnController := controller.NewReturnController(returnInteractor)
+
+	_ = returnRepo.Create(&domain.ReturnRequest{OrderID: 12345, MemberNumber: "M12345", ProductNumber: "P12345", Quantity: 1, Amount: 1000, Reason: "파손", Status: domain.ReturnStatusRequested, RequestedAt: time.Now()})
+
+	router := gin.Default()
+	router.GET("/returns/me", func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		returnController.GetMyReturns(c)
+	})
+
+	req, _ := http.NewRequest("GET", "/returns/me", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var response []map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response, 1)
+}
+
+func TestReturnController_ChangeReturnStatus_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	returnRepo := repository.NewReturnRequestRepository(db)
+	orderRepo := repository.NewOrderRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	returnInteractor := usecases.NewReturnInteractor(returnRepo, orderRepo, productRepo, gateway.NewFakePaymentGateway(), db)
+	returnController := controller.NewReturnController(returnInteractor)
+
+	returnRequest := &domain.ReturnRequest{OrderID: 12345, MemberNumber: "M12345", ProductNumber: "P12345", Quantity: 1, Amount: 1000, Reason: "파손", Status: domain.ReturnStatusRequested, RequestedAt: time.Now()}
+	_ = returnRepo.Create(returnRequest)
+
+	router := gin.Default()
+	router.PUT("/returns/:id/status", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		returnController.ChangeReturnStatus(c)
+	})
+
+	body := []byte(`{"status":"approved"}`)
+	req, _ := http.NewRequest("PUT", "/returns/1/status", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "approved", response["status"])
+}
+
+func TestReturnController_ChangeReturnStatus_Failure_Unauthorized(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	returnRepo := repository.NewReturnRequestRepository(db)
+	orderRepo := repository.NewOrderRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	returnInteractor := usecases.NewReturnInteractor(returnRepo, orderRepo, productRepo, gateway.NewFakePaymentGateway(), db)
+	returnController := controller.NewReturnController(returnInteractor)
+
+	router := gin.Default()
+	router.PUT("/returns/:id/status", func(c *gin.Context) {
+		c.Set("is_admin", false)
+		returnController.ChangeReturnStatus(c)
+	})
+
+	body := []byte(`{"status":"approved"}`)
+	req, _ := http.NewRequest("PUT", "/returns/1/status", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
@@ -0,0 +1,178 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/gin-gonic/gin"
+)
+
+type ShipmentController struct {
+	shipmentInteractor *usecases.ShipmentInteractor
+}
+
+func NewShipmentController(si *usecases.ShipmentInteractor) *ShipmentController {
+	return &ShipmentController{shipmentInteractor: si}
+}
+
+// CreateShipment godoc
+// @Summary      배송 등록
+// @Description  택배사에 운송장을 등록하고 주문을 배송 중으로 변경합니다. (관리자 전용)
+// @Tags         shipments
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "주문 기본키 (primary key)"
+// @Success      201 {object} response.ShipmentResponse "등록 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "등록 실패"
+// @Router       /orders/{id}/shipments [post]
+func (sc *ShipmentController) CreateShipment(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	orderParam := c.Param("id")
+	orderId, err := strconv.Atoi(orderParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 주문 ID입니다."})
+		return
+	}
+
+	responseData, err := sc.shipmentInteractor.CreateShipment(orderId)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, responseData)
+}
+
+// GetOrderShipments godoc
+// @Summary      주문 배송 조회
+// @Description  인증된 사용자의 주문에 등록된 배송 목록을 조회합니다.
+// @Tags         shipments
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "주문 기본키 (primary key)"
+// @Success      200 {array} response.ShipmentResponse "배송 목록"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      500 {object} map[string]string "배송 조회 실패"
+// @Router       /orders/{id}/shipments [get]
+func (sc *ShipmentController) GetOrderShipments(c *gin.Context) {
+	orderParam := c.Param("id")
+	orderId, err := strconv.Atoi(orderParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 주문 ID입니다."})
+		return
+	}
+
+	memberNumber := c.GetString("member_number")
+
+	responseData, err := sc.shipmentInteractor.GetOrderShipments(orderId, memberNumber)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// GetOrdersAwaitingShipment godoc
+// @Summary      배송 대기 주문 조회
+// @Description  결제 승인 또는 결제 완료 후 아직 배송이 등록되지 않은 주문 목록을 조회합니다. (관리자 전용)
+// @Tags         shipments
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Success      200 {array} response.OrderResponse "주문 목록"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "주문 조회 실패"
+// @Router       /shipments/pending [get]
+func (sc *ShipmentController) GetOrdersAwaitingShipment(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	responseData, err := sc.shipmentInteractor.GetOrdersAwaitingShipment()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "주문 목록을 가져올 수 없습니다."})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// DeliverShipment godoc
+// @Summary      배송 완료 처리
+// @Description  배송을 완료 처리합니다. 주문의 모든 배송이 완료되면 주문도 배송 완료로 변경됩니다. (관리자 전용)
+// @Tags         shipments
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "배송 기본키 (primary key)"
+// @Success      200 {object} response.ShipmentResponse "처리 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "처리 실패"
+// @Router       /shipments/{id}/deliver [put]
+func (sc *ShipmentController) DeliverShipment(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 배송 ID입니다."})
+		return
+	}
+
+	responseData, err := sc.shipmentInteractor.DeliverShipment(id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// SyncTracking godoc
+// @Summary      배송 추적 갱신
+// @Description  택배사에서 배송 상태를 조회해 배송 완료 여부를 반영합니다. (관리자 전용)
+// @Tags         shipments
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "배송 기본키 (primary key)"
+// @Success      200 {object} response.ShipmentResponse "갱신 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "갱신 실패"
+// @Router       /shipments/{id}/tracking [put]
+func (sc *ShipmentController) SyncTracking(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 배송 ID입니다."})
+		return
+	}
+
+	responseData, err := sc.shipmentInteractor.SyncTracking(id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
@@ -0,0 +1,77 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/gateway"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/controller"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShipmentController_CreateShipment_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	shipmentInteractor := usecases.NewShipmentInteractor(repository.NewShipmentRepository(db), orderRepo, gateway.NewFakeCarrierGateway(), gateway.NewFakePaymentGateway(), db)
+	shipmentController := controller.NewShipmentController(shipmentInteractor)
+	_ = orderRepo.Create(&domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPaid,
+	})
+
+	router := gin.Default()
+	router.POST("/orders/:id/shipments", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		shipmentController.CreateShipment(c)
+	})
+
+	req, _ := http.NewRequest("POST", "/orders/12345/shipments", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(response["tracking_number"].(string), "FAKE-O12345-"))
+}
+
+func TestShipmentController_GetOrdersAwaitingShipment_Failure_Unauthorized(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	shipmentInteractor := usecases.NewShipmentInteractor(repository.NewShipmentRepository(db), repository.NewOrderRepository(db), gateway.NewFakeCarrierGateway(), gateway.NewFakePaymentGateway(), db)
+	shipmentController := controller.NewShipmentController(shipmentInteractor)
+
+	router := gin.Default()
+	router.GET("/shipments/pending", func(c *gin.Context) {
+		c.Set("is_admin", false)
+		shipmentController.GetOrdersAwaitingShipment(c)
+	})
+
+	req, _ := http.NewRequest("GET", "/shipments/pending", nil)
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
@@ -0,0 +1,189 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/gin-gonic/gin"
+)
+
+type TaxClassController struct {
+	taxClassInteractor *usecases.TaxClassInteractor
+}
+
+func NewTaxClassController(ti *usecases.TaxClassInteractor) *TaxClassController {
+	return &TaxClassController{taxClassInteractor: ti}
+}
+
+// CreateTaxClass godoc
+// @Summary      과세 분류 생성
+// @Description  새로운 과세 분류를 등록합니다. (관리자 전용)
+// @Tags         tax-classes
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        taxClassRequest body request.CreateTaxClassRequest true "과세 분류 정보"
+// @Success      201 {object} response.TaxClassResponse "생성 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "생성 실패"
+// @Router       /tax-classes [post]
+func (tc *TaxClassController) CreateTaxClass(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	var req request.CreateTaxClassRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	responseData, err := tc.taxClassInteractor.CreateTaxClass(&req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, responseData)
+}
+
+// GetTaxClasses godoc
+// @Summary      과세 분류 목록 조회
+// @Description  전체 과세 분류 목록을 조회합니다. (관리자 전용)
+// @Tags         tax-classes
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Success      200 {array} response.TaxClassResponse "과세 분류 목록"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "조회 실패"
+// @Router       /tax-classes [get]
+func (tc *TaxClassController) GetTaxClasses(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	responseData, err := tc.taxClassInteractor.GetTaxClasses()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "과세 분류 목록을 가져올 수 없습니다."})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// GetTaxClass godoc
+// @Summary      과세 분류 상세 조회
+// @Description  과세 분류 정보를 조회합니다. (관리자 전용)
+// @Tags         tax-classes
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Success      200 {object} response.TaxClassResponse "과세 분류 정보"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      404 {object} map[string]string "과세 분류 없음"
+// @Router       /tax-classes/{id} [get]
+func (tc *TaxClassController) GetTaxClass(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 과세 분류 ID입니다."})
+		return
+	}
+
+	responseData, err := tc.taxClassInteractor.GetTaxClass(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "과세 분류를 찾을 수 없습니다."})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// UpdateTaxClass godoc
+// @Summary      과세 분류 수정
+// @Description  과세 분류 정보를 수정합니다. 과세 분류명은 변경할 수 없습니다. (관리자 전용)
+// @Tags         tax-classes
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Param        taxClassRequest body request.UpdateTaxClassRequest true "수정할 과세 분류 정보"
+// @Success      200 {object} response.TaxClassResponse "수정 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "수정 실패"
+// @Router       /tax-classes/{id} [put]
+func (tc *TaxClassController) UpdateTaxClass(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 과세 분류 ID입니다."})
+		return
+	}
+
+	var req request.UpdateTaxClassRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+		return
+	}
+
+	responseData, err := tc.taxClassInteractor.UpdateTaxClass(id, &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, responseData)
+}
+
+// DeleteTaxClass godoc
+// @Summary      과세 분류 삭제
+// @Description  과세 분류를 삭제합니다. (관리자 전용)
+// @Tags         tax-classes
+// @Security     Bearer
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "기본키 (primary key)"
+// @Success      200 {object} map[string]string "삭제 성공"
+// @Failure      400 {object} map[string]string "잘못된 요청"
+// @Failure      403 {object} map[string]string "권한 없음"
+// @Failure      500 {object} map[string]string "삭제 실패"
+// @Router       /tax-classes/{id} [delete]
+func (tc *TaxClassController) DeleteTaxClass(c *gin.Context) {
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다."})
+		return
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 과세 분류 ID입니다."})
+		return
+	}
+
+	if err := tc.taxClassInteractor.DeleteTaxClass(id); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "과세 분류가 삭제되었습니다."})
+}
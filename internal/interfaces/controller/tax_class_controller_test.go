@@ -0,0 +1,67 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/controller"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxClassController_CreateTaxClass_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	taxClassInteractor := usecases.NewTaxClassInteractor(repository.NewTaxClassRepository(db))
+	taxClassController := controller.NewTaxClassController(taxClassInteractor)
+
+	router := gin.Default()
+	router.POST("/tax-classes", func(c *gin.Context) {
+		c.Set("is_admin", true)
+		taxClassController.CreateTaxClass(c)
+	})
+
+	body := []byte(`{"name":"식품 경감세율","rate":800,"price_mode":"inclusive","categories":["food"]}`)
+	req, _ := http.NewRequest("POST", "/tax-classes", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "식품 경감세율", response["name"])
+}
+
+func TestTaxClassController_CreateTaxClass_Failure_Unauthorized(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	taxClassInteractor := usecases.NewTaxClassInteractor(repository.NewTaxClassRepository(db))
+	taxClassController := controller.NewTaxClassController(taxClassInteractor)
+
+	router := gin.Default()
+	router.POST("/tax-classes", func(c *gin.Context) {
+		c.Set("is_admin", false)
+		taxClassController.CreateTaxClass(c)
+	})
+
+	req, _ := http.NewRequest("POST", "/tax-classes", bytes.NewBuffer([]byte(`{}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
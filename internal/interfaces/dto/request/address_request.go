@@ -0,0 +1,46 @@
+package request
+
+import "github.com/HongJungWan/commerce-system/internal/domain"
+
+type AddressRequest struct {
+	Label         string `json:"label" example:"집"`
+	RecipientName string `json:"recipient_name" example:"홍길동"`
+	Phone         string `json:"phone" example:"010-1234-5678"`
+	ZipCode       string `json:"zip_code" example:"06236"`
+	BaseAddress   string `json:"base_address" example:"서울특별시 강남구 테헤란로 123"`
+	DetailAddress string `json:"detail_address" example:"4층"`
+	IsDefault     bool   `json:"is_default" example:"true"`
+}
+
+func (req *AddressRequest) CreateToEntity(memberNumber string) (*domain.Address, error) {
+	address := &domain.Address{
+		MemberNumber:  memberNumber,
+		Label:         req.Label,
+		PostalAddress: req.toPostalAddress(),
+		IsDefault:     req.IsDefault,
+	}
+
+	if err := address.Validate(); err != nil {
+		return nil, err
+	}
+
+	return address, nil
+}
+
+func (req *AddressRequest) ApplyToEntity(address *domain.Address) error {
+	address.Label = req.Label
+	address.PostalAddress = req.toPostalAddress()
+	address.IsDefault = req.IsDefault
+
+	return address.Validate()
+}
+
+func (req *AddressRequest) toPostalAddress() domain.PostalAddress {
+	return domain.PostalAddress{
+		RecipientName: req.RecipientName,
+		Phone:         req.Phone,
+		ZipCode:       req.ZipCode,
+		BaseAddress:   req.BaseAddress,
+		DetailAddress: req.DetailAddress,
+	}
+}
@@ -0,0 +1,15 @@
+package request
+
+type AddCartItemRequest struct {
+	ProductNumber string `json:"product_number" example:"Product0fe0dfb2-0a9e-4e47-b670-5d1a761e62b5"`
+	Quantity      int    `json:"quantity" example:"2"`
+}
+
+type UpdateCartItemRequest struct {
+	Quantity int `json:"quantity" example:"3"`
+}
+
+type CheckoutCartRequest struct {
+	CouponCode string `json:"coupon_code,omitempty" example:"WELCOME10"`
+	AddressID  int    `json:"address_id,omitempty" example:"1"`
+}
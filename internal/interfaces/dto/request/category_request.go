@@ -0,0 +1,43 @@
+package request
+
+import (
+	"strings"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+)
+
+type CreateCategoryRequest struct {
+	Name      string `json:"name" example:"과자"`
+	Slug      string `json:"slug" example:"snacks"`
+	ParentID  *int   `json:"parent_id,omitempty" example:"1"`
+	SortOrder int    `json:"sort_order" example:"0"`
+}
+
+// UpdateCategoryRequest 는 슬러그를 제외한 항목을 수정합니다. parent_id를 생략하면 최상위 카테고리가 됩니다.
+type UpdateCategoryRequest struct {
+	Name      string `json:"name" example:"스낵"`
+	ParentID  *int   `json:"parent_id,omitempty" example:"1"`
+	SortOrder int    `json:"sort_order" example:"1"`
+}
+
+func (req *CreateCategoryRequest) CreateToEntity() (*domain.Category, error) {
+	category := &domain.Category{
+		ParentID:  req.ParentID,
+		Name:      strings.TrimSpace(req.Name),
+		Slug:      strings.ToLower(strings.TrimSpace(req.Slug)),
+		SortOrder: req.SortOrder,
+	}
+
+	if err := category.Validate(); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (req *UpdateCategoryRequest) ApplyToEntity(category *domain.Category) error {
+	category.Name = strings.TrimSpace(req.Name)
+	category.SortOrder = req.SortOrder
+
+	return category.Validate()
+}
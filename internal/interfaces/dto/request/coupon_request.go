@@ -0,0 +1,75 @@
+package request
+
+import (
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+)
+
+type CreateCouponRequest struct {
+	Code              string    `json:"code" example:"WELCOME10"`
+	Name              string    `json:"name" example:"신규 회원 10% 할인"`
+	DiscountType      string    `json:"discount_type" example:"percentage"`
+	DiscountValue     int64     `json:"discount_value" example:"10"`
+	MaxDiscountAmount int64     `json:"max_discount_amount" example:"5000"`
+	MinOrderAmount    int64     `json:"min_order_amount" example:"10000"`
+	StartsAt          time.Time `json:"starts_at" example:"2024-09-01T00:00:00Z"`
+	EndsAt            time.Time `json:"ends_at" example:"2024-10-01T00:00:00Z"`
+	UsageLimit        int       `json:"usage_limit" example:"100"`
+	PerMemberLimit    int       `json:"per_member_limit" example:"1"`
+	Categories        []string  `json:"categories"`
+	ProductNumbers    []string  `json:"product_numbers"`
+}
+
+type UpdateCouponRequest struct {
+	Name              string    `json:"name" example:"신규 회원 10% 할인"`
+	DiscountType      string    `json:"discount_type" example:"percentage"`
+	DiscountValue     int64     `json:"discount_value" example:"10"`
+	MaxDiscountAmount int64     `json:"max_discount_amount" example:"5000"`
+	MinOrderAmount    int64     `json:"min_order_amount" example:"10000"`
+	StartsAt          time.Time `json:"starts_at" example:"2024-09-01T00:00:00Z"`
+	EndsAt            time.Time `json:"ends_at" example:"2024-10-01T00:00:00Z"`
+	UsageLimit        int       `json:"usage_limit" example:"100"`
+	PerMemberLimit    int       `json:"per_member_limit" example:"1"`
+	Categories        []string  `json:"categories"`
+	ProductNumbers    []string  `json:"product_numbers"`
+}
+
+func (req *CreateCouponRequest) CreateToEntity() (*domain.Coupon, error) {
+	coupon := &domain.Coupon{
+		Code:              req.Code,
+		Name:              req.Name,
+		DiscountType:      domain.CouponDiscountType(req.DiscountType),
+		DiscountValue:     req.DiscountValue,
+		MaxDiscountAmount: req.MaxDiscountAmount,
+		MinOrderAmount:    req.MinOrderAmount,
+		StartsAt:          req.StartsAt,
+		EndsAt:            req.EndsAt,
+		UsageLimit:        req.UsageLimit,
+		PerMemberLimit:    req.PerMemberLimit,
+		Categories:        req.Categories,
+		ProductNumbers:    req.ProductNumbers,
+	}
+
+	if err := coupon.Validate(); err != nil {
+		return nil, err
+	}
+
+	return coupon, nil
+}
+
+func (req *UpdateCouponRequest) ApplyToEntity(coupon *domain.Coupon) error {
+	coupon.Name = req.Name
+	coupon.DiscountType = domain.CouponDiscountType(req.DiscountType)
+	coupon.DiscountValue = req.DiscountValue
+	coupon.MaxDiscountAmount = req.MaxDiscountAmount
+	coupon.MinOrderAmount = req.MinOrderAmount
+	coupon.StartsAt = req.StartsAt
+	coupon.EndsAt = req.EndsAt
+	coupon.UsageLimit = req.UsageLimit
+	coupon.PerMemberLimit = req.PerMemberLimit
+	coupon.Categories = req.Categories
+	coupon.ProductNumbers = req.ProductNumbers
+
+	return coupon.Validate()
+}
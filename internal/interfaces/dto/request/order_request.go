@@ -1,19 +1,43 @@
 package request
 
 import (
+	"errors"
+	"strings"
+	"time"
+
 	"github.com/HongJungWan/commerce-system/internal/domain"
 	"github.com/google/uuid"
-	"time"
 )
 
 const (
 	ORDER = "Order"
+
+	dateLayout = "2006-01-02"
 )
 
 type CreateOrderRequest struct {
+	Items      []CreateOrderItemRequest `json:"items"`
+	CouponCode string                   `json:"coupon_code,omitempty" example:"WELCOME10"`
+	AddressID  int                      `json:"address_id,omitempty" example:"1"`
+}
+
+type CreateOrderItemRequest struct {
 	ProductNumber string `json:"product_number" example:"Product0fe0dfb2-0a9e-4e47-b670-5d1a761e62b5"`
 	Quantity      int    `json:"quantity" example:"2"`
-	Price         int64  `json:"price" example:"1000"`
+}
+
+type UpdateOrderStatusRequest struct {
+	Status string `json:"status" example:"shipped"`
+}
+
+type PartialCancelOrderRequest struct {
+	ProductNumber string `json:"product_number" example:"Product0fe0dfb2-0a9e-4e47-b670-5d1a761e62b5"`
+	Quantity      int    `json:"quantity" example:"1"`
+}
+
+type AdminCancelOrderRequest struct {
+	ReasonCode string `json:"reason_code" example:"fraud"`
+	Note       string `json:"note" example:"도용된 카드로 결제된 주문입니다."`
 }
 
 type CancelOrderRequest struct {
@@ -21,14 +45,27 @@ type CancelOrderRequest struct {
 }
 
 func (req *CreateOrderRequest) CreateToEntity(memberNumber string) (*domain.Order, error) {
+	items := make([]domain.OrderItem, 0, len(req.Items))
+	indexes := make(map[string]int)
+	for _, item := range req.Items {
+		// 같은 상품이 여러 번 요청되면 하나의 주문 상품으로 합산
+		if idx, ok := indexes[item.ProductNumber]; ok && item.ProductNumber != "" {
+			items[idx].Quantity += item.Quantity
+			continue
+		}
+		indexes[item.ProductNumber] = len(items)
+		items = append(items, domain.OrderItem{
+			ProductNumber: item.ProductNumber,
+			Quantity:      item.Quantity,
+		})
+	}
+
 	order := &domain.Order{
-		OrderNumber:   ORDER + uuid.New().String(),
-		OrderDate:     time.Now(),
-		MemberNumber:  memberNumber,
-		ProductNumber: req.ProductNumber,
-		Price:         req.Price,
-		Quantity:      req.Quantity,
-		TotalAmount:   req.Price * int64(req.Quantity),
+		OrderNumber:  ORDER + uuid.New().String(),
+		OrderDate:    time.Now(),
+		MemberNumber: memberNumber,
+		Items:        items,
+		Status:       domain.OrderStatusPending,
 	}
 
 	if err := order.Validate(); err != nil {
@@ -37,3 +74,125 @@ func (req *CreateOrderRequest) CreateToEntity(memberNumber string) (*domain.Orde
 
 	return order, nil
 }
+
+type OrderHistoryRequest struct {
+	Status string `form:"status" example:"paid,shipped"`
+	From   string `form:"from" example:"2024-01-01"`
+	To     string `form:"to" example:"2024-01-31"`
+	Sort   string `form:"sort" example:"latest"`
+	Cursor int    `form:"cursor" example:"0"`
+	Limit  int    `form:"limit" example:"20"`
+}
+
+func (req *OrderHistoryRequest) ToQuery(memberNumber string) (*domain.OrderQuery, error) {
+	sort, err := domain.ParseOrderSort(req.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &domain.OrderQuery{
+		MemberNumber: memberNumber,
+		Sort:         sort,
+		Cursor:       req.Cursor,
+		Limit:        req.Limit,
+	}
+
+	query.Statuses, err = parseOrderStatuses(req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	query.From, query.To, err = parseDateRange(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	return query, nil
+}
+
+type AdminOrderSearchRequest struct {
+	OrderNumber   string `form:"order_number" example:"Order1234567890"`
+	MemberNumber  string `form:"member_number" example:"Member1234567890"`
+	ProductNumber string `form:"product_number" example:"Product0fe0dfb2-0a9e-4e47-b670-5d1a761e62b5"`
+	Status        string `form:"status" example:"paid,shipped"`
+	From          string `form:"from" example:"2024-01-01"`
+	To            string `form:"to" example:"2024-01-31"`
+	Sort          string `form:"sort" example:"latest"`
+	Page          int    `form:"page" example:"1"`
+	Size          int    `form:"size" example:"20"`
+}
+
+func (req *AdminOrderSearchRequest) ToCriteria() (*domain.OrderSearchCriteria, error) {
+	sort, err := domain.ParseOrderSearchSort(req.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria := &domain.OrderSearchCriteria{
+		OrderNumber:   req.OrderNumber,
+		MemberNumber:  req.MemberNumber,
+		ProductNumber: req.ProductNumber,
+		Sort:          sort,
+		Page:          req.Page,
+		Size:          req.Size,
+	}
+
+	criteria.Statuses, err = parseOrderStatuses(req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria.From, criteria.To, err = parseDateRange(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := criteria.Validate(); err != nil {
+		return nil, err
+	}
+
+	return criteria, nil
+}
+
+// parseOrderStatuses 는 쉼표로 구분된 주문 상태 목록을 변환합니다.
+func parseOrderStatuses(value string) ([]domain.OrderStatus, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var statuses []domain.OrderStatus
+	for _, status := range strings.Split(value, ",") {
+		parsed, err := domain.ParseOrderStatus(strings.TrimSpace(status))
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, parsed)
+	}
+	return statuses, nil
+}
+
+// parseDateRange 는 YYYY-MM-DD 형식의 조회 기간을 변환합니다.
+// 종료일 당일의 주문까지 포함하도록 종료 시각은 다음 날 0시로 설정합니다.
+func parseDateRange(fromValue, toValue string) (*time.Time, *time.Time, error) {
+	var from, to *time.Time
+	if fromValue != "" {
+		parsed, err := time.ParseInLocation(dateLayout, fromValue, time.Local)
+		if err != nil {
+			return nil, nil, errors.New("조회 시작일 형식이 잘못되었습니다.")
+		}
+		from = &parsed
+	}
+	if toValue != "" {
+		parsed, err := time.ParseInLocation(dateLayout, toValue, time.Local)
+		if err != nil {
+			return nil, nil, errors.New("조회 종료일 형식이 잘못되었습니다.")
+		}
+		parsed = parsed.AddDate(0, 0, 1)
+		to = &parsed
+	}
+	return from, to, nil
+}
@@ -1,6 +1,9 @@
 package request
 
 import (
+	"errors"
+	"strings"
+
 	"github.com/HongJungWan/commerce-system/internal/domain"
 	"github.com/google/uuid"
 )
@@ -11,22 +14,114 @@ const (
 
 type CreateProductRequest struct {
 	ProductName   string `json:"product_name" example:"pizza"`
-	Category      string `json:"category" example:"food"`
+	CategoryID    *int   `json:"category_id,omitempty" example:"1"`
+	Description   string `json:"description" example:"치즈가 듬뿍 들어간 피자"`
 	Price         int64  `json:"price" example:"1000"`
 	StockQuantity int    `json:"stock_quantity" example:"100"`
+	Weight        int    `json:"weight" example:"500"`
+	Width         int    `json:"width" example:"30"`
+	Length        int    `json:"length" example:"30"`
+	Height        int    `json:"height" example:"5"`
+	// 옵션을 지정하면 재고 없이 상위 상품으로 등록되며, 옵션 상품을 추가해 판매
+	Options []ProductOptionRequest `json:"options,omitempty"`
+}
+
+type ProductSearchRequest struct {
+	Keyword  string `form:"keyword" example:"피자"`
+	Category string `form:"category" example:"food,snacks"`
+	MinPrice *int64 `form:"min_price" example:"1000"`
+	MaxPrice *int64 `form:"max_price" example:"50000"`
+	InStock  bool   `form:"in_stock" example:"true"`
+	Sort     string `form:"sort" example:"relevance"`
+	Cursor   string `form:"cursor" example:""`
+	Limit    int    `form:"limit" example:"20"`
+}
+
+func (req *ProductSearchRequest) ToQuery() (*domain.ProductQuery, error) {
+	keyword := strings.TrimSpace(req.Keyword)
+	sort, err := domain.ParseProductSort(req.Sort, keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &domain.ProductQuery{
+		Keyword:  keyword,
+		MinPrice: req.MinPrice,
+		MaxPrice: req.MaxPrice,
+		InStock:  req.InStock,
+		Sort:     sort,
+		Limit:    req.Limit,
+	}
+
+	for _, category := range strings.Split(req.Category, ",") {
+		if category = strings.TrimSpace(category); category != "" {
+			query.Categories = append(query.Categories, category)
+		}
+	}
+
+	if req.Cursor != "" {
+		if query.Cursor, err = domain.DecodeProductCursor(req.Cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	return query, nil
+}
+
+type ProductOptionRequest struct {
+	Name   string   `json:"name" example:"size"`
+	Values []string `json:"values" example:"S,M,L"`
+}
+
+type AddVariantRequest struct {
+	SKU          string            `json:"sku" example:"TSHIRT-RED-M"`
+	OptionValues map[string]string `json:"option_values"`
+	// 지정하지 않으면 상위 상품 가격으로 판매
+	Price         *int64 `json:"price,omitempty" example:"12000"`
+	StockQuantity int    `json:"stock_quantity" example:"30"`
+}
+
+// UpdateProductRequest 는 지정한 항목만 수정합니다. 재고수량은 재고 API로만 변경할 수 있습니다.
+type UpdateProductRequest struct {
+	ProductName *string `json:"product_name,omitempty" example:"cheese pizza"`
+	CategoryID  *int    `json:"category_id,omitempty" example:"1"`
+	Description *string `json:"description,omitempty" example:"치즈가 듬뿍 들어간 피자"`
+	Price       *int64  `json:"price,omitempty" example:"1200"`
+	Weight      *int    `json:"weight,omitempty" example:"550"`
+	Width       *int    `json:"width,omitempty" example:"30"`
+	Length      *int    `json:"length,omitempty" example:"30"`
+	Height      *int    `json:"height,omitempty" example:"5"`
 }
 
 type UpdateStockRequest struct {
 	StockQuantity int `json:"stock_quantity" example:"77"`
+	// 지정하면 현재 재고가 이 값과 같을 때만 수정
+	ExpectedStockQuantity *int `json:"expected_stock_quantity,omitempty" example:"70"`
+}
+
+type AdjustStockRequest struct {
+	Delta  int    `json:"delta" example:"-3"`
+	Reason string `json:"reason" example:"파손 상품 폐기"`
 }
 
 func (req *CreateProductRequest) CreateToEntity() (*domain.Product, error) {
 	product := &domain.Product{
 		ProductNumber: PRODUCT + uuid.New().String(),
 		ProductName:   req.ProductName,
-		Category:      req.Category,
+		Description:   req.Description,
 		Price:         req.Price,
 		StockQuantity: req.StockQuantity,
+		Weight:        req.Weight,
+		Width:         req.Width,
+		Length:        req.Length,
+		Height:        req.Height,
+	}
+	for _, option := range req.Options {
+		product.Options = append(product.Options, domain.ProductOption{Name: option.Name, Values: option.Values})
 	}
 
 	if err := product.Validate(); err != nil {
@@ -35,3 +130,37 @@ func (req *CreateProductRequest) CreateToEntity() (*domain.Product, error) {
 
 	return product, nil
 }
+
+func (req *AddVariantRequest) ToEntity(parent *domain.Product) (*domain.Product, error) {
+	return parent.NewVariant(PRODUCT+uuid.New().String(), req.SKU, req.OptionValues, req.Price, req.StockQuantity)
+}
+
+// ApplyToEntity 는 가격과 카테고리를 제외한 항목을 상품에 반영합니다.
+// 가격은 이력 기록을 위해 Product.ChangePrice로, 카테고리는 조회 후 Product.AssignCategory로 변경합니다.
+func (req *UpdateProductRequest) ApplyToEntity(product *domain.Product) error {
+	if req.ProductName == nil && req.CategoryID == nil && req.Description == nil && req.Price == nil &&
+		req.Weight == nil && req.Width == nil && req.Length == nil && req.Height == nil {
+		return errors.New("수정할 항목이 없습니다.")
+	}
+
+	if req.ProductName != nil {
+		product.ProductName = *req.ProductName
+	}
+	if req.Description != nil {
+		product.Description = *req.Description
+	}
+	if req.Weight != nil {
+		product.Weight = *req.Weight
+	}
+	if req.Width != nil {
+		product.Width = *req.Width
+	}
+	if req.Length != nil {
+		product.Length = *req.Length
+	}
+	if req.Height != nil {
+		product.Height = *req.Height
+	}
+
+	return product.Validate()
+}
@@ -0,0 +1,11 @@
+package request
+
+type CreateReturnRequest struct {
+	ProductNumber string `json:"product_number" example:"Product0fe0dfb2-0a9e-4e47-b670-5d1a761e62b5"`
+	Quantity      int    `json:"quantity" example:"1"`
+	Reason        string `json:"reason" example:"상품이 파손되어 도착했습니다."`
+}
+
+type UpdateReturnStatusRequest struct {
+	Status string `json:"status" example:"approved"`
+}
@@ -0,0 +1,43 @@
+package request
+
+import "github.com/HongJungWan/commerce-system/internal/domain"
+
+type CreateTaxClassRequest struct {
+	Name       string   `json:"name" example:"식품 경감세율"`
+	Rate       int64    `json:"rate" example:"800"`
+	PriceMode  string   `json:"price_mode" example:"inclusive"`
+	IsDefault  bool     `json:"is_default" example:"false"`
+	Categories []string `json:"categories"`
+}
+
+type UpdateTaxClassRequest struct {
+	Rate       int64    `json:"rate" example:"1000"`
+	PriceMode  string   `json:"price_mode" example:"exclusive"`
+	IsDefault  bool     `json:"is_default" example:"true"`
+	Categories []string `json:"categories"`
+}
+
+func (req *CreateTaxClassRequest) CreateToEntity() (*domain.TaxClass, error) {
+	taxClass := &domain.TaxClass{
+		Name:       req.Name,
+		Rate:       req.Rate,
+		PriceMode:  domain.TaxPriceMode(req.PriceMode),
+		IsDefault:  req.IsDefault,
+		Categories: req.Categories,
+	}
+
+	if err := taxClass.Validate(); err != nil {
+		return nil, err
+	}
+
+	return taxClass, nil
+}
+
+func (req *UpdateTaxClassRequest) ApplyToEntity(taxClass *domain.TaxClass) error {
+	taxClass.Rate = req.Rate
+	taxClass.PriceMode = domain.TaxPriceMode(req.PriceMode)
+	taxClass.IsDefault = req.IsDefault
+	taxClass.Categories = req.Categories
+
+	return taxClass.Validate()
+}
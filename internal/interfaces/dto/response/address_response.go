@@ -0,0 +1,54 @@
+package response
+
+import (
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+)
+
+type AddressResponse struct {
+	ID            int    `json:"id"`
+	Label         string `json:"label"`
+	RecipientName string `json:"recipient_name"`
+	Phone         string `json:"phone"`
+	ZipCode       string `json:"zip_code"`
+	BaseAddress   string `json:"base_address"`
+	DetailAddress string `json:"detail_address"`
+	IsDefault     bool   `json:"is_default"`
+	CreatedAt     string `json:"created_at"`
+}
+
+type PostalAddressResponse struct {
+	RecipientName string `json:"recipient_name"`
+	Phone         string `json:"phone"`
+	ZipCode       string `json:"zip_code"`
+	BaseAddress   string `json:"base_address"`
+	DetailAddress string `json:"detail_address"`
+}
+
+func NewAddressResponse(address *domain.Address) *AddressResponse {
+	return &AddressResponse{
+		ID:            address.ID,
+		Label:         address.Label,
+		RecipientName: address.PostalAddress.RecipientName,
+		Phone:         address.PostalAddress.Phone,
+		ZipCode:       address.PostalAddress.ZipCode,
+		BaseAddress:   address.PostalAddress.BaseAddress,
+		DetailAddress: address.PostalAddress.DetailAddress,
+		IsDefault:     address.IsDefault,
+		CreatedAt:     address.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewPostalAddressResponse(address *domain.PostalAddress) *PostalAddressResponse {
+	if address.IsEmpty() {
+		return nil
+	}
+	return &PostalAddressResponse{
+		RecipientName: address.RecipientName,
+		Phone:         address.Phone,
+		ZipCode:       address.ZipCode,
+		BaseAddress:   address.BaseAddress,
+		DetailAddress: address.DetailAddress,
+	}
+}
@@ -0,0 +1,55 @@
+package response
+
+import (
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+)
+
+type CartResponse struct {
+	MemberNumber string             `json:"member_number"`
+	Items        []CartItemResponse `json:"items"`
+	TotalAmount  int64              `json:"total_amount"`
+	UpdatedAt    string             `json:"updated_at,omitempty"`
+}
+
+type CartItemResponse struct {
+	ProductNumber string `json:"product_number"`
+	ProductName   string `json:"product_name"`
+	Price         int64  `json:"price"`
+	Quantity      int    `json:"quantity"`
+	LineTotal     int64  `json:"line_total"`
+	StockQuantity int    `json:"stock_quantity"`
+	IsAvailable   bool   `json:"is_available"`
+}
+
+func NewCartResponse(cart *domain.Cart, products map[string]*domain.Product) *CartResponse {
+	items := make([]CartItemResponse, 0, len(cart.Items))
+	var totalAmount int64
+	for _, item := range cart.Items {
+		itemResponse := CartItemResponse{
+			ProductNumber: item.ProductNumber,
+			Quantity:      item.Quantity,
+		}
+		// 장바구니에는 현재 상품 가격과 재고를 기준으로 표시
+		if product, ok := products[item.ProductNumber]; ok {
+			itemResponse.ProductName = product.ProductName
+			itemResponse.Price = product.Price
+			itemResponse.LineTotal = product.Price * int64(item.Quantity)
+			itemResponse.StockQuantity = product.StockQuantity
+			itemResponse.IsAvailable = !product.IsArchived() && product.StockQuantity >= item.Quantity
+			totalAmount += itemResponse.LineTotal
+		}
+		items = append(items, itemResponse)
+	}
+
+	cartResponse := &CartResponse{
+		MemberNumber: cart.MemberNumber,
+		Items:        items,
+		TotalAmount:  totalAmount,
+	}
+	if !cart.UpdatedAt.IsZero() {
+		cartResponse.UpdatedAt = cart.UpdatedAt.Format(time.RFC3339)
+	}
+	return cartResponse
+}
@@ -0,0 +1,46 @@
+package response
+
+import "github.com/HongJungWan/commerce-system/internal/domain"
+
+type CategoryResponse struct {
+	ID        int                `json:"id"`
+	ParentID  *int               `json:"parent_id,omitempty"`
+	Name      string             `json:"name"`
+	Slug      string             `json:"slug"`
+	SortOrder int                `json:"sort_order"`
+	Children  []CategoryResponse `json:"children,omitempty"`
+}
+
+func NewCategoryResponse(category *domain.Category) *CategoryResponse {
+	return &CategoryResponse{
+		ID:        category.ID,
+		ParentID:  category.ParentID,
+		Name:      category.Name,
+		Slug:      category.Slug,
+		SortOrder: category.SortOrder,
+	}
+}
+
+// NewCategoryTreeResponse 는 정렬된 카테고리 목록을 최상위 카테고리부터 하위 카테고리를 포함한 트리로 변환합니다.
+func NewCategoryTreeResponse(categories []*domain.Category) []CategoryResponse {
+	var roots []*domain.Category
+	childrenOf := make(map[int][]*domain.Category)
+	for _, category := range categories {
+		if category.ParentID == nil {
+			roots = append(roots, category)
+			continue
+		}
+		childrenOf[*category.ParentID] = append(childrenOf[*category.ParentID], category)
+	}
+	return buildCategoryTree(roots, childrenOf)
+}
+
+func buildCategoryTree(categories []*domain.Category, childrenOf map[int][]*domain.Category) []CategoryResponse {
+	categoryResponses := make([]CategoryResponse, 0, len(categories))
+	for _, category := range categories {
+		categoryResponse := NewCategoryResponse(category)
+		categoryResponse.Children = buildCategoryTree(childrenOf[category.ID], childrenOf)
+		categoryResponses = append(categoryResponses, *categoryResponse)
+	}
+	return categoryResponses
+}
@@ -0,0 +1,43 @@
+package response
+
+import (
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+)
+
+type CouponResponse struct {
+	ID                int      `json:"id"`
+	Code              string   `json:"code"`
+	Name              string   `json:"name"`
+	DiscountType      string   `json:"discount_type"`
+	DiscountValue     int64    `json:"discount_value"`
+	MaxDiscountAmount int64    `json:"max_discount_amount"`
+	MinOrderAmount    int64    `json:"min_order_amount"`
+	StartsAt          string   `json:"starts_at"`
+	EndsAt            string   `json:"ends_at"`
+	UsageLimit        int      `json:"usage_limit"`
+	PerMemberLimit    int      `json:"per_member_limit"`
+	UsedCount         int      `json:"used_count"`
+	Categories        []string `json:"categories"`
+	ProductNumbers    []string `json:"product_numbers"`
+}
+
+func NewCouponResponse(coupon *domain.Coupon) *CouponResponse {
+	return &CouponResponse{
+		ID:                coupon.ID,
+		Code:              coupon.Code,
+		Name:              coupon.Name,
+		DiscountType:      string(coupon.DiscountType),
+		DiscountValue:     coupon.DiscountValue,
+		MaxDiscountAmount: coupon.MaxDiscountAmount,
+		MinOrderAmount:    coupon.MinOrderAmount,
+		StartsAt:          coupon.StartsAt.Format(time.RFC3339),
+		EndsAt:            coupon.EndsAt.Format(time.RFC3339),
+		UsageLimit:        coupon.UsageLimit,
+		PerMemberLimit:    coupon.PerMemberLimit,
+		UsedCount:         coupon.UsedCount,
+		Categories:        coupon.Categories,
+		ProductNumbers:    coupon.ProductNumbers,
+	}
+}
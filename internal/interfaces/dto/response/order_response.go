@@ -7,16 +7,78 @@ import (
 )
 
 type OrderResponse struct {
-	ID            int    `json:"id"`
-	OrderNumber   string `json:"order_number"`
-	OrderDate     string `json:"order_date"`
-	MemberNumber  string `json:"member_number"`
+	ID              int                         `json:"id"`
+	OrderNumber     string                      `json:"order_number"`
+	OrderDate       string                      `json:"order_date"`
+	MemberNumber    string                      `json:"member_number"`
+	Items           []OrderItemResponse         `json:"items"`
+	ShippingAddress *PostalAddressResponse      `json:"shipping_address,omitempty"`
+	TotalAmount     int64                       `json:"total_amount"`
+	CouponCode      string                      `json:"coupon_code,omitempty"`
+	DiscountAmount  int64                       `json:"discount_amount"`
+	TaxAmount       int64                       `json:"tax_amount"`
+	IncludedTax     int64                       `json:"included_tax"`
+	ShippingFee     int64                       `json:"shipping_fee"`
+	PaymentAmount   int64                       `json:"payment_amount"`
+	CanceledAmount  int64                       `json:"canceled_amount"`
+	Status          string                      `json:"status"`
+	PaidAt          string                      `json:"paid_at,omitempty"`
+	ShippedAt       string                      `json:"shipped_at,omitempty"`
+	DeliveredAt     string                      `json:"delivered_at,omitempty"`
+	CanceledAt      string                      `json:"canceled_at,omitempty"`
+	CanceledBy      string                      `json:"canceled_by,omitempty"`
+	CancelReason    string                      `json:"cancel_reason,omitempty"`
+	CancelNote      string                      `json:"cancel_note,omitempty"`
+	Payment         *PaymentResponse            `json:"payment,omitempty"`
+	Cancellations   []OrderCancellationResponse `json:"cancellations,omitempty"`
+}
+
+type OrderCancellationResponse struct {
 	ProductNumber string `json:"product_number"`
-	Price         int64  `json:"price"`
 	Quantity      int    `json:"quantity"`
-	TotalAmount   int64  `json:"total_amount"`
-	IsCanceled    bool   `json:"is_canceled"`
-	CanceledAt    string `json:"canceled_at,omitempty"`
+	Amount        int64  `json:"amount"`
+	CanceledAt    string `json:"canceled_at"`
+}
+
+type PaymentResponse struct {
+	Provider       string `json:"provider"`
+	TransactionID  string `json:"transaction_id"`
+	Amount         int64  `json:"amount"`
+	RefundedAmount int64  `json:"refunded_amount"`
+	PendingRefund  int64  `json:"pending_refund"`
+	Status         string `json:"status"`
+	AuthorizedAt   string `json:"authorized_at"`
+	CapturedAt     string `json:"captured_at,omitempty"`
+	RefundedAt     string `json:"refunded_at,omitempty"`
+}
+
+type OrderItemResponse struct {
+	ID               int               `json:"id"`
+	ProductNumber    string            `json:"product_number"`
+	ProductName      string            `json:"product_name"`
+	SKU              string            `json:"sku,omitempty"`
+	OptionValues     map[string]string `json:"option_values,omitempty"`
+	Price            int64             `json:"price"`
+	Quantity         int               `json:"quantity"`
+	CanceledQuantity int               `json:"canceled_quantity"`
+	LineTotal        int64             `json:"line_total"`
+	DiscountAmount   int64             `json:"discount_amount"`
+	TaxAmount        int64             `json:"tax_amount"`
+	TaxInclusive     bool              `json:"tax_inclusive"`
+}
+
+type OrderListResponse struct {
+	Orders     []OrderResponse `json:"orders"`
+	HasNext    bool            `json:"has_next"`
+	NextCursor int             `json:"next_cursor,omitempty"`
+}
+
+type OrderPageResponse struct {
+	Orders     []OrderResponse `json:"orders"`
+	Page       int             `json:"page"`
+	Size       int             `json:"size"`
+	TotalCount int64           `json:"total_count"`
+	TotalPages int             `json:"total_pages"`
 }
 
 type CreateOrderResponse struct {
@@ -24,17 +86,103 @@ type CreateOrderResponse struct {
 	Order   OrderResponse `json:"order"`
 }
 
+func NewOrderListResponse(orders []*domain.Order, hasNext bool) *OrderListResponse {
+	orderResponses := make([]OrderResponse, 0, len(orders))
+	for _, order := range orders {
+		orderResponses = append(orderResponses, *NewOrderResponse(order))
+	}
+
+	listResponse := &OrderListResponse{
+		Orders:  orderResponses,
+		HasNext: hasNext,
+	}
+	if hasNext {
+		listResponse.NextCursor = orders[len(orders)-1].ID
+	}
+	return listResponse
+}
+
+func NewOrderPageResponse(orders []*domain.Order, page, size int, totalCount int64) *OrderPageResponse {
+	orderResponses := make([]OrderResponse, 0, len(orders))
+	for _, order := range orders {
+		orderResponses = append(orderResponses, *NewOrderResponse(order))
+	}
+
+	return &OrderPageResponse{
+		Orders:     orderResponses,
+		Page:       page,
+		Size:       size,
+		TotalCount: totalCount,
+		TotalPages: int((totalCount + int64(size) - 1) / int64(size)),
+	}
+}
+
 func NewOrderResponse(order *domain.Order) *OrderResponse {
-	return &OrderResponse{
-		ID:            order.ID,
-		OrderNumber:   order.OrderNumber,
-		OrderDate:     order.OrderDate.Format(time.RFC3339),
-		MemberNumber:  order.MemberNumber,
-		ProductNumber: order.ProductNumber,
-		Price:         order.Price,
-		Quantity:      order.Quantity,
-		TotalAmount:   order.TotalAmount,
-		IsCanceled:    order.IsCanceled,
-		CanceledAt:    helper.FormatTime(order.CanceledAt),
+	items := make([]OrderItemResponse, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, OrderItemResponse{
+			ID:               item.ID,
+			ProductNumber:    item.ProductNumber,
+			ProductName:      item.ProductName,
+			SKU:              item.SKU,
+			OptionValues:     item.OptionValues,
+			Price:            item.Price,
+			Quantity:         item.Quantity,
+			CanceledQuantity: item.CanceledQuantity,
+			LineTotal:        item.LineTotal,
+			DiscountAmount:   item.DiscountAmount,
+			TaxAmount:        item.TaxAmount,
+			TaxInclusive:     item.TaxInclusive,
+		})
+	}
+
+	var cancellations []OrderCancellationResponse
+	for _, cancellation := range order.Cancellations {
+		cancellations = append(cancellations, OrderCancellationResponse{
+			ProductNumber: cancellation.ProductNumber,
+			Quantity:      cancellation.Quantity,
+			Amount:        cancellation.Amount,
+			CanceledAt:    cancellation.CanceledAt.Format(time.RFC3339),
+		})
+	}
+
+	orderResponse := &OrderResponse{
+		ID:              order.ID,
+		OrderNumber:     order.OrderNumber,
+		OrderDate:       order.OrderDate.Format(time.RFC3339),
+		MemberNumber:    order.MemberNumber,
+		Items:           items,
+		ShippingAddress: NewPostalAddressResponse(&order.ShippingAddress),
+		TotalAmount:     order.TotalAmount,
+		CouponCode:      order.CouponCode,
+		DiscountAmount:  order.DiscountAmount,
+		TaxAmount:       order.TaxAmount,
+		IncludedTax:     order.IncludedTax,
+		ShippingFee:     order.ShippingFee,
+		PaymentAmount:   order.PaymentAmount(),
+		CanceledAmount:  order.CanceledAmount,
+		Status:          string(order.Status),
+		PaidAt:          helper.FormatTime(order.PaidAt),
+		ShippedAt:       helper.FormatTime(order.ShippedAt),
+		DeliveredAt:     helper.FormatTime(order.DeliveredAt),
+		CanceledAt:      helper.FormatTime(order.CanceledAt),
+		CanceledBy:      order.CanceledBy,
+		CancelReason:    string(order.CancelReason),
+		CancelNote:      order.CancelNote,
+		Cancellations:   cancellations,
+	}
+	if order.Payment != nil {
+		orderResponse.Payment = &PaymentResponse{
+			Provider:       order.Payment.Provider,
+			TransactionID:  order.Payment.TransactionID,
+			Amount:         order.Payment.Amount,
+			RefundedAmount: order.Payment.RefundedAmount,
+			PendingRefund:  order.Payment.PendingRefund,
+			Status:         string(order.Payment.Status),
+			AuthorizedAt:   order.Payment.AuthorizedAt.Format(time.RFC3339),
+			CapturedAt:     helper.FormatTime(order.Payment.CapturedAt),
+			RefundedAt:     helper.FormatTime(order.Payment.RefundedAt),
+		}
 	}
+	return orderResponse
 }
@@ -1,7 +1,43 @@
 package response
 
+import "github.com/HongJungWan/commerce-system/internal/domain"
+
 type OrderStatsResponse struct {
 	Month         string `json:"month"`
 	TotalSales    int64  `json:"total_sales"`
+	NetSales      int64  `json:"net_sales"`
+	TotalTax      int64  `json:"total_tax"`
+	GrossSales    int64  `json:"gross_sales"`
 	TotalCanceled int64  `json:"total_canceled"`
+	TotalRefunded int64  `json:"total_refunded"`
+
+	CancelReasons []CancelReasonStatResponse `json:"cancel_reasons"`
+}
+
+type CancelReasonStatResponse struct {
+	Reason string `json:"reason"`
+	Count  int64  `json:"count"`
+	Amount int64  `json:"amount"`
+}
+
+func NewOrderStatsResponse(month string, stats *domain.OrderStats) *OrderStatsResponse {
+	cancelReasons := make([]CancelReasonStatResponse, 0, len(stats.CancelReasons))
+	for _, stat := range stats.CancelReasons {
+		cancelReasons = append(cancelReasons, CancelReasonStatResponse{
+			Reason: string(stat.Reason),
+			Count:  stat.Count,
+			Amount: stat.Amount,
+		})
+	}
+
+	return &OrderStatsResponse{
+		Month:         month,
+		TotalSales:    stats.TotalSales,
+		NetSales:      stats.NetSales,
+		TotalTax:      stats.TotalTax,
+		GrossSales:    stats.GrossSales,
+		TotalCanceled: stats.TotalCanceled,
+		TotalRefunded: stats.TotalRefunded,
+		CancelReasons: cancelReasons,
+	}
 }
@@ -0,0 +1,54 @@
+package response
+
+import "github.com/HongJungWan/commerce-system/internal/domain"
+
+type PriceBreakdownResponse struct {
+	Items          []PriceBreakdownItemResponse `json:"items"`
+	CouponCode     string                       `json:"coupon_code,omitempty"`
+	BaseAmount     int64                        `json:"base_amount"`
+	DiscountAmount int64                        `json:"discount_amount"`
+	TaxAmount      int64                        `json:"tax_amount"`
+	IncludedTax    int64                        `json:"included_tax"`
+	ShippingFee    int64                        `json:"shipping_fee"`
+	TotalAmount    int64                        `json:"total_amount"`
+}
+
+type PriceBreakdownItemResponse struct {
+	ProductNumber  string `json:"product_number"`
+	ProductName    string `json:"product_name"`
+	Price          int64  `json:"price"`
+	Quantity       int    `json:"quantity"`
+	BaseAmount     int64  `json:"base_amount"`
+	DiscountAmount int64  `json:"discount_amount"`
+	TaxAmount      int64  `json:"tax_amount"`
+	TaxInclusive   bool   `json:"tax_inclusive"`
+	TotalAmount    int64  `json:"total_amount"`
+}
+
+func NewPriceBreakdownResponse(breakdown *domain.PriceBreakdown) *PriceBreakdownResponse {
+	items := make([]PriceBreakdownItemResponse, 0, len(breakdown.Items))
+	for _, item := range breakdown.Items {
+		items = append(items, PriceBreakdownItemResponse{
+			ProductNumber:  item.ProductNumber,
+			ProductName:    item.ProductName,
+			Price:          item.Price,
+			Quantity:       item.Quantity,
+			BaseAmount:     item.BaseAmount,
+			DiscountAmount: item.DiscountAmount,
+			TaxAmount:      item.TaxAmount,
+			TaxInclusive:   item.TaxInclusive,
+			TotalAmount:    item.TotalAmount,
+		})
+	}
+
+	return &PriceBreakdownResponse{
+		Items:          items,
+		CouponCode:     breakdown.CouponCode,
+		BaseAmount:     breakdown.BaseAmount,
+		DiscountAmount: breakdown.DiscountAmount,
+		TaxAmount:      breakdown.TaxAmount,
+		IncludedTax:    breakdown.IncludedTax,
+		ShippingFee:    breakdown.ShippingFee,
+		TotalAmount:    breakdown.TotalAmount,
+	}
+}
@@ -1,14 +1,45 @@
 package response
 
-import "github.com/HongJungWan/commerce-system/internal/domain"
+import (
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+)
 
 type ProductResponse struct {
 	ID            int    `json:"id"`
 	ProductNumber string `json:"product_number"`
 	ProductName   string `json:"product_name"`
+	CategoryID    *int   `json:"category_id,omitempty"`
 	Category      string `json:"category"`
+	Description   string `json:"description,omitempty"`
 	Price         int64  `json:"price"`
 	StockQuantity int    `json:"stock_quantity"`
+	Weight        int    `json:"weight"`
+	Width         int    `json:"width"`
+	Length        int    `json:"length"`
+	Height        int    `json:"height"`
+	ArchivedAt    string `json:"archived_at,omitempty"`
+	IsAvailable   bool   `json:"is_available"`
+
+	ParentProductNumber string                  `json:"parent_product_number,omitempty"`
+	SKU                 string                  `json:"sku,omitempty"`
+	InheritsPrice       bool                    `json:"inherits_price,omitempty"`
+	Options             []ProductOptionResponse `json:"options,omitempty"`
+	OptionValues        map[string]string       `json:"option_values,omitempty"`
+	Variants            []ProductResponse       `json:"variants,omitempty"`
+}
+
+type ProductOptionResponse struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+type ProductListResponse struct {
+	Products   []ProductResponse `json:"products"`
+	TotalCount int64             `json:"total_count"`
+	HasNext    bool              `json:"has_next"`
+	NextCursor string            `json:"next_cursor,omitempty"`
 }
 
 type CreateProductResponse struct {
@@ -17,12 +48,85 @@ type CreateProductResponse struct {
 }
 
 func NewProductResponse(product *domain.Product) *ProductResponse {
-	return &ProductResponse{
+	productResponse := &ProductResponse{
 		ID:            product.ID,
 		ProductNumber: product.ProductNumber,
 		ProductName:   product.ProductName,
+		CategoryID:    product.CategoryID,
 		Category:      product.Category,
+		Description:   product.Description,
 		Price:         product.Price,
 		StockQuantity: product.StockQuantity,
+		Weight:        product.Weight,
+		Width:         product.Width,
+		Length:        product.Length,
+		Height:        product.Height,
+		IsAvailable:   product.CheckOrderable() == nil && product.StockQuantity > 0,
+
+		ParentProductNumber: product.ParentProductNumber,
+		SKU:                 product.SKU,
+		InheritsPrice:       product.InheritsPrice(),
+		OptionValues:        product.OptionValues,
+	}
+	if product.ArchivedAt != nil {
+		productResponse.ArchivedAt = product.ArchivedAt.Format(time.RFC3339)
+	}
+	for _, option := range product.Options {
+		productResponse.Options = append(productResponse.Options, ProductOptionResponse{Name: option.Name, Values: option.Values})
+	}
+	return productResponse
+}
+
+// NewProductGroupResponse 는 옵션 상품을 상위 상품 아래에 묶고, 판매 중인 옵션 상품의 재고를 합산해 구매 가능 여부를 표시합니다.
+func NewProductGroupResponse(product *domain.Product, variants []*domain.Product) *ProductResponse {
+	productResponse := NewProductResponse(product)
+	if !product.HasVariants() {
+		return productResponse
+	}
+
+	productResponse.StockQuantity = 0
+	for _, variant := range variants {
+		if variant.IsArchived() {
+			continue
+		}
+		variantResponse := NewProductResponse(variant)
+		productResponse.Variants = append(productResponse.Variants, *variantResponse)
+		productResponse.StockQuantity += variant.StockQuantity
+		if variantResponse.IsAvailable {
+			productResponse.IsAvailable = !product.IsArchived()
+		}
+	}
+	return productResponse
+}
+
+type ProductPriceChangeResponse struct {
+	ID            int    `json:"id"`
+	ProductNumber string `json:"product_number"`
+	OldPrice      int64  `json:"old_price"`
+	NewPrice      int64  `json:"new_price"`
+	ChangedBy     string `json:"changed_by,omitempty"`
+	ChangedAt     string `json:"changed_at"`
+}
+
+func NewProductListResponse(products []ProductResponse, totalCount int64, next *domain.ProductCursor) *ProductListResponse {
+	listResponse := &ProductListResponse{
+		Products:   products,
+		TotalCount: totalCount,
+		HasNext:    next != nil,
+	}
+	if next != nil {
+		listResponse.NextCursor = next.Encode()
+	}
+	return listResponse
+}
+
+func NewProductPriceChangeResponse(change *domain.ProductPriceChange) *ProductPriceChangeResponse {
+	return &ProductPriceChangeResponse{
+		ID:            change.ID,
+		ProductNumber: change.ProductNumber,
+		OldPrice:      change.OldPrice,
+		NewPrice:      change.NewPrice,
+		ChangedBy:     change.ChangedBy,
+		ChangedAt:     change.ChangedAt.Format(time.RFC3339),
 	}
 }
@@ -0,0 +1,42 @@
+package response
+
+import (
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/helper"
+)
+
+type ReturnResponse struct {
+	ID            int    `json:"id"`
+	OrderID       int    `json:"order_id"`
+	MemberNumber  string `json:"member_number"`
+	ProductNumber string `json:"product_number"`
+	Quantity      int    `json:"quantity"`
+	Amount        int64  `json:"amount"`
+	Reason        string `json:"reason"`
+	Status        string `json:"status"`
+	RequestedAt   string `json:"requested_at"`
+	ApprovedAt    string `json:"approved_at,omitempty"`
+	ReceivedAt    string `json:"received_at,omitempty"`
+	RefundedAt    string `json:"refunded_at,omitempty"`
+	RejectedAt    string `json:"rejected_at,omitempty"`
+}
+
+func NewReturnResponse(returnRequest *domain.ReturnRequest) *ReturnResponse {
+	return &ReturnResponse{
+		ID:            returnRequest.ID,
+		OrderID:       returnRequest.OrderID,
+		MemberNumber:  returnRequest.MemberNumber,
+		ProductNumber: returnRequest.ProductNumber,
+		Quantity:      returnRequest.Quantity,
+		Amount:        returnRequest.Amount,
+		Reason:        returnRequest.Reason,
+		Status:        string(returnRequest.Status),
+		RequestedAt:   returnRequest.RequestedAt.Format(time.RFC3339),
+		ApprovedAt:    helper.FormatTime(returnRequest.ApprovedAt),
+		ReceivedAt:    helper.FormatTime(returnRequest.ReceivedAt),
+		RefundedAt:    helper.FormatTime(returnRequest.RefundedAt),
+		RejectedAt:    helper.FormatTime(returnRequest.RejectedAt),
+	}
+}
@@ -0,0 +1,30 @@
+package response
+
+import (
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/helper"
+)
+
+type ShipmentResponse struct {
+	ID             int    `json:"id"`
+	OrderID        int    `json:"order_id"`
+	Carrier        string `json:"carrier"`
+	TrackingNumber string `json:"tracking_number"`
+	Status         string `json:"status"`
+	ShippedAt      string `json:"shipped_at"`
+	DeliveredAt    string `json:"delivered_at,omitempty"`
+}
+
+func NewShipmentResponse(shipment *domain.Shipment) *ShipmentResponse {
+	return &ShipmentResponse{
+		ID:             shipment.ID,
+		OrderID:        shipment.OrderID,
+		Carrier:        shipment.Carrier,
+		TrackingNumber: shipment.TrackingNumber,
+		Status:         string(shipment.Status),
+		ShippedAt:      shipment.ShippedAt.Format(time.RFC3339),
+		DeliveredAt:    helper.FormatTime(shipment.DeliveredAt),
+	}
+}
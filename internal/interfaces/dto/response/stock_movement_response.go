@@ -0,0 +1,33 @@
+package response
+
+import (
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+)
+
+type StockMovementResponse struct {
+	ID            int    `json:"id"`
+	ProductNumber string `json:"product_number"`
+	Type          string `json:"type"`
+	Delta         int    `json:"delta"`
+	Balance       int    `json:"balance"`
+	Actor         string `json:"actor,omitempty"`
+	Reference     string `json:"reference,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+	CreatedAt     string `json:"created_at"`
+}
+
+func NewStockMovementResponse(movement *domain.StockMovement) *StockMovementResponse {
+	return &StockMovementResponse{
+		ID:            movement.ID,
+		ProductNumber: movement.ProductNumber,
+		Type:          string(movement.Type),
+		Delta:         movement.Delta,
+		Balance:       movement.Balance,
+		Actor:         movement.Actor,
+		Reference:     movement.Reference,
+		Reason:        movement.Reason,
+		CreatedAt:     movement.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,23 @@
+package response
+
+import "github.com/HongJungWan/commerce-system/internal/domain"
+
+type TaxClassResponse struct {
+	ID         int      `json:"id"`
+	Name       string   `json:"name"`
+	Rate       int64    `json:"rate"`
+	PriceMode  string   `json:"price_mode"`
+	IsDefault  bool     `json:"is_default"`
+	Categories []string `json:"categories"`
+}
+
+func NewTaxClassResponse(taxClass *domain.TaxClass) *TaxClassResponse {
+	return &TaxClassResponse{
+		ID:         taxClass.ID,
+		Name:       taxClass.Name,
+		Rate:       taxClass.Rate,
+		PriceMode:  string(taxClass.PriceMode),
+		IsDefault:  taxClass.IsDefault,
+		Categories: taxClass.Categories,
+	}
+}
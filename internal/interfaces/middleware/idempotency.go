@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+type idempotencyResponseWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *idempotencyResponseWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *idempotencyResponseWriter) WriteString(data string) (int, error) {
+	w.body.WriteString(data)
+	return w.ResponseWriter.WriteString(data)
+}
+
+// JWTAuthMiddleware 뒤에 등록해야 회원별로 멱등 키를 구분할 수 있습니다.
+func IdempotencyMiddleware(repo repository.IdempotencyKeyRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Idempotency-Key 헤더가 없으면 일반 요청으로 처리
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		memberNumber := c.GetString("member_number")
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청입니다."})
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+		requestHash := hashRequest(c.Request.Method, c.Request.URL.Path, body)
+
+		// 유효 기간이 지난 키는 삭제하고 새 요청으로 처리
+		stored, err := repo.GetByKey(memberNumber, key)
+		if err == nil && stored.IsExpired(time.Now()) {
+			if err := repo.Delete(stored.ID); err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "요청을 처리할 수 없습니다."})
+				c.Abort()
+				return
+			}
+			err = gorm.ErrRecordNotFound
+		}
+
+		// 이미 처리된 키이면 저장된 응답을 재전송
+		if err == nil {
+			if !stored.Matches(requestHash) {
+				c.JSON(http.StatusConflict, gin.H{"error": "같은 Idempotency-Key로 다른 요청이 전송되었습니다."})
+				c.Abort()
+				return
+			}
+			if !stored.IsCompleted() {
+				c.JSON(http.StatusConflict, gin.H{"error": "같은 Idempotency-Key의 요청이 처리 중입니다."})
+				c.Abort()
+				return
+			}
+			c.Header("Idempotent-Replayed", "true")
+			c.Data(stored.ResponseStatus, "application/json; charset=utf-8", []byte(stored.ResponseBody))
+			c.Abort()
+			return
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "요청을 처리할 수 없습니다."})
+			c.Abort()
+			return
+		}
+
+		// 처리 중 상태로 먼저 저장해 동시에 들어온 같은 키의 요청을 막음
+		idempotencyKey := &domain.IdempotencyKey{
+			MemberNumber: memberNumber,
+			Key:          key,
+			RequestHash:  requestHash,
+		}
+		if err := repo.Create(idempotencyKey); err != nil {
+			c.JSON(http.StatusConflict, gin.H{"error": "같은 Idempotency-Key의 요청이 처리 중입니다."})
+			c.Abort()
+			return
+		}
+
+		// 서버 오류나 핸들러 패닉으로 응답을 저장하지 못하면 키를 삭제해 재시도를 허용
+		defer func() {
+			if !idempotencyKey.IsCompleted() {
+				_ = repo.Delete(idempotencyKey.ID)
+			}
+		}()
+
+		writer := &idempotencyResponseWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = writer
+		c.Next()
+
+		if writer.Status() >= http.StatusInternalServerError {
+			return
+		}
+		idempotencyKey.Complete(writer.Status(), writer.body.String())
+		_ = repo.Update(idempotencyKey)
+	}
+}
+
+func hashRequest(method string, path string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte(path))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
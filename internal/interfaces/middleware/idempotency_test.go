@@ -0,0 +1,157 @@
+package middleware_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/middleware"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupIdempotencyRouter(status int, calls *int) *gin.Engine {
+	repo := repository.NewIdempotencyKeyRepository(fixtures.SetupTestDB())
+	return newIdempotencyRouter(repo, func(c *gin.Context) {
+		*calls++
+		c.JSON(status, gin.H{"call": *calls})
+	})
+}
+
+func newIdempotencyRouter(repo *repository.IdempotencyKeyRepositoryImpl, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(gin.Recovery())
+	router.Use(func(c *gin.Context) {
+		c.Set("member_number", "M12345")
+		c.Next()
+	})
+	router.Use(middleware.IdempotencyMiddleware(repo))
+	router.POST("/orders", handler)
+	return router
+}
+
+func sendIdempotentRequest(router *gin.Engine, key string, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("POST", "/orders", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(middleware.IdempotencyKeyHeader, key)
+	}
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	return resp
+}
+
+func TestIdempotencyMiddleware_Success_ReplayResponse(t *testing.T) {
+	// Given
+	calls := 0
+	router := setupIdempotencyRouter(http.StatusCreated, &calls)
+	first := sendIdempotentRequest(router, "key-1", `{"items":[]}`)
+
+	// When
+	second := sendIdempotentRequest(router, "key-1", `{"items":[]}`)
+
+	// Then
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	assert.Equal(t, "true", second.Header().Get("Idempotent-Replayed"))
+}
+
+func TestIdempotencyMiddleware_Fail_DifferentBody(t *testing.T) {
+	// Given
+	calls := 0
+	router := setupIdempotencyRouter(http.StatusCreated, &calls)
+	sendIdempotentRequest(router, "key-1", `{"items":[]}`)
+
+	// When
+	resp := sendIdempotentRequest(router, "key-1", `{"items":[{"product_number":"P1"}]}`)
+
+	// Then
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, http.StatusConflict, resp.Code)
+}
+
+func TestIdempotencyMiddleware_Success_WithoutKey(t *testing.T) {
+	// Given
+	calls := 0
+	router := setupIdempotencyRouter(http.StatusCreated, &calls)
+	sendIdempotentRequest(router, "", `{"items":[]}`)
+
+	// When
+	resp := sendIdempotentRequest(router, "", `{"items":[]}`)
+
+	// Then
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, http.StatusCreated, resp.Code)
+}
+
+func TestIdempotencyMiddleware_Success_RetryAfterServerError(t *testing.T) {
+	// Given
+	calls := 0
+	router := setupIdempotencyRouter(http.StatusInternalServerError, &calls)
+	sendIdempotentRequest(router, "key-1", `{"items":[]}`)
+
+	// When
+	resp := sendIdempotentRequest(router, "key-1", `{"items":[]}`)
+
+	// Then
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
+
+func TestIdempotencyMiddleware_Success_RetryAfterPanic(t *testing.T) {
+	// Given
+	calls := 0
+	repo := repository.NewIdempotencyKeyRepository(fixtures.SetupTestDB())
+	router := newIdempotencyRouter(repo, func(c *gin.Context) {
+		calls++
+		if calls == 1 {
+			panic("unexpected")
+		}
+		c.JSON(http.StatusCreated, gin.H{"call": calls})
+	})
+	first := sendIdempotentRequest(router, "key-1", `{"items":[]}`)
+
+	// When
+	resp := sendIdempotentRequest(router, "key-1", `{"items":[]}`)
+
+	// Then
+	assert.Equal(t, http.StatusInternalServerError, first.Code)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, http.StatusCreated, resp.Code)
+}
+
+func TestIdempotencyMiddleware_Success_ExpiredKey(t *testing.T) {
+	// Given
+	calls := 0
+	repo := repository.NewIdempotencyKeyRepository(fixtures.SetupTestDB())
+	router := newIdempotencyRouter(repo, func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{"call": calls})
+	})
+	expired := &domain.IdempotencyKey{
+		MemberNumber: "M12345",
+		Key:          "key-1",
+		RequestHash:  "hash-1",
+		CreatedAt:    time.Now().Add(-domain.IdempotencyKeyTTL - time.Minute),
+	}
+	expired.Complete(http.StatusCreated, `{"call":0}`)
+	assert.NoError(t, repo.Create(expired))
+
+	// When
+	resp := sendIdempotentRequest(router, "key-1", `{"items":[]}`)
+
+	// Then
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	assert.Empty(t, resp.Header().Get("Idempotent-Replayed"))
+	stored, err := repo.GetByKey("M12345", "key-1")
+	assert.NoError(t, err)
+	assert.False(t, stored.IsExpired(time.Now()))
+}
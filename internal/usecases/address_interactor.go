@@ -0,0 +1,110 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/response"
+	"gorm.io/gorm"
+)
+
+type AddressInteractor struct {
+	AddressRepository repository.AddressRepository
+	DB                *gorm.DB
+}
+
+func NewAddressInteractor(ar repository.AddressRepository, db *gorm.DB) *AddressInteractor {
+	return &AddressInteractor{
+		AddressRepository: ar,
+		DB:                db,
+	}
+}
+
+func (ai *AddressInteractor) CreateAddress(memberNumber string, req *request.AddressRequest) (*response.AddressResponse, error) {
+	address, err := req.CreateToEntity(memberNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ai.DB.Transaction(func(tx *gorm.DB) error {
+		addressRepo := ai.AddressRepository.WithTx(tx)
+
+		// 첫 번째 배송지는 기본 배송지로 등록
+		count, err := addressRepo.CountByMemberNumber(memberNumber)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			address.IsDefault = true
+		}
+		if address.IsDefault {
+			if err := addressRepo.ClearDefault(memberNumber); err != nil {
+				return err
+			}
+		}
+		return addressRepo.Create(address)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.NewAddressResponse(address), nil
+}
+
+func (ai *AddressInteractor) GetMyAddresses(memberNumber string) ([]response.AddressResponse, error) {
+	addresses, err := ai.AddressRepository.GetByMemberNumber(memberNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	addressResponses := make([]response.AddressResponse, 0, len(addresses))
+	for _, address := range addresses {
+		addressResponses = append(addressResponses, *response.NewAddressResponse(address))
+	}
+	return addressResponses, nil
+}
+
+func (ai *AddressInteractor) UpdateAddress(id int, memberNumber string, req *request.AddressRequest) (*response.AddressResponse, error) {
+	var address *domain.Address
+	err := ai.DB.Transaction(func(tx *gorm.DB) error {
+		addressRepo := ai.AddressRepository.WithTx(tx)
+
+		var err error
+		address, err = ai.getOwnedAddress(addressRepo, id, memberNumber)
+		if err != nil {
+			return err
+		}
+		if err := req.ApplyToEntity(address); err != nil {
+			return err
+		}
+		if address.IsDefault {
+			if err := addressRepo.ClearDefault(memberNumber); err != nil {
+				return err
+			}
+		}
+		return addressRepo.Update(address)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.NewAddressResponse(address), nil
+}
+
+func (ai *AddressInteractor) DeleteAddress(id int, memberNumber string) error {
+	if _, err := ai.getOwnedAddress(ai.AddressRepository, id, memberNumber); err != nil {
+		return err
+	}
+	return ai.AddressRepository.Delete(id)
+}
+
+func (ai *AddressInteractor) getOwnedAddress(addressRepo repository.AddressRepository, id int, memberNumber string) (*domain.Address, error) {
+	address, err := addressRepo.GetById(id)
+	if err != nil {
+		return nil, errors.New("배송지를 찾을 수 없습니다.")
+	}
+	if !address.IsOwnedBy(memberNumber) {
+		return nil, errors.New("해당 배송지에 대한 권한이 없습니다.")
+	}
+	return address, nil
+}
@@ -0,0 +1,84 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAddressRequest(isDefault bool) *request.AddressRequest {
+	return &request.AddressRequest{
+		Label:         "집",
+		RecipientName: "홍길동",
+		Phone:         "010-1234-5678",
+		ZipCode:       "06236",
+		BaseAddress:   "서울특별시 강남구 테헤란로 123",
+		DetailAddress: "4층",
+		IsDefault:     isDefault,
+	}
+}
+
+func TestAddressInteractor_CreateAddress_Success_FirstIsDefault(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewAddressInteractor(repository.NewAddressRepository(db), db)
+
+	// When
+	responseData, err := interactor.CreateAddress("M12345", newAddressRequest(false))
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, responseData.IsDefault)
+}
+
+func TestAddressInteractor_CreateAddress_Success_ReplaceDefault(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewAddressInteractor(repository.NewAddressRepository(db), db)
+	first, _ := interactor.CreateAddress("M12345", newAddressRequest(true))
+
+	// When
+	second, err := interactor.CreateAddress("M12345", newAddressRequest(true))
+
+	// Then
+	assert.NoError(t, err)
+	addresses, _ := interactor.GetMyAddresses("M12345")
+	assert.Len(t, addresses, 2)
+	assert.Equal(t, second.ID, addresses[0].ID)
+	assert.True(t, addresses[0].IsDefault)
+	assert.Equal(t, first.ID, addresses[1].ID)
+	assert.False(t, addresses[1].IsDefault)
+}
+
+func TestAddressInteractor_UpdateAddress_Failure_NotOwner(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewAddressInteractor(repository.NewAddressRepository(db), db)
+	created, _ := interactor.CreateAddress("M12345", newAddressRequest(true))
+
+	// When
+	_, err := interactor.UpdateAddress(created.ID, "M99999", newAddressRequest(true))
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "해당 배송지에 대한 권한이 없습니다.", err.Error())
+}
+
+func TestAddressInteractor_DeleteAddress_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewAddressInteractor(repository.NewAddressRepository(db), db)
+	created, _ := interactor.CreateAddress("M12345", newAddressRequest(true))
+
+	// When
+	err := interactor.DeleteAddress(created.ID, "M12345")
+
+	// Then
+	assert.NoError(t, err)
+	addresses, _ := interactor.GetMyAddresses("M12345")
+	assert.Empty(t, addresses)
+}
@@ -0,0 +1,136 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/response"
+	"gorm.io/gorm"
+)
+
+type CartInteractor struct {
+	CartRepository    repository.CartRepository
+	ProductRepository repository.ProductRepository
+	OrderInteractor   *OrderInteractor
+}
+
+func NewCartInteractor(cr repository.CartRepository, pr repository.ProductRepository, oi *OrderInteractor) *CartInteractor {
+	return &CartInteractor{
+		CartRepository:    cr,
+		ProductRepository: pr,
+		OrderInteractor:   oi,
+	}
+}
+
+func (ci *CartInteractor) GetMyCart(memberNumber string) (*response.CartResponse, error) {
+	cart, err := ci.loadCart(memberNumber)
+	if err != nil {
+		return nil, err
+	}
+	return ci.toCartResponse(cart)
+}
+
+func (ci *CartInteractor) AddItem(memberNumber string, req *request.AddCartItemRequest) (*response.CartResponse, error) {
+	cart, err := ci.loadCart(memberNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := ci.ProductRepository.GetByProductNumber(req.ProductNumber)
+	if err != nil || product == nil {
+		return nil, errors.New("유효하지 않은 상품 번호입니다.")
+	}
+	if err := checkOrderable(ci.ProductRepository, product); err != nil {
+		return nil, err
+	}
+
+	if err := cart.AddItem(product.ProductNumber, req.Quantity); err != nil {
+		return nil, err
+	}
+	if err := ci.CartRepository.Save(cart); err != nil {
+		return nil, err
+	}
+	return ci.toCartResponse(cart)
+}
+
+func (ci *CartInteractor) UpdateItem(memberNumber string, productNumber string, req *request.UpdateCartItemRequest) (*response.CartResponse, error) {
+	cart, err := ci.loadCart(memberNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cart.UpdateItem(productNumber, req.Quantity); err != nil {
+		return nil, err
+	}
+	if err := ci.CartRepository.Save(cart); err != nil {
+		return nil, err
+	}
+	return ci.toCartResponse(cart)
+}
+
+func (ci *CartInteractor) RemoveItem(memberNumber string, productNumber string) (*response.CartResponse, error) {
+	cart, err := ci.loadCart(memberNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cart.RemoveItem(productNumber); err != nil {
+		return nil, err
+	}
+	if err := ci.CartRepository.Save(cart); err != nil {
+		return nil, err
+	}
+	return ci.toCartResponse(cart)
+}
+
+func (ci *CartInteractor) Checkout(memberNumber string, req *request.CheckoutCartRequest) (*response.CreateOrderResponse, error) {
+	cart, err := ci.loadCart(memberNumber)
+	if err != nil {
+		return nil, err
+	}
+	if cart.IsEmpty() {
+		return nil, errors.New("장바구니가 비어 있습니다.")
+	}
+
+	orderRequest := &request.CreateOrderRequest{CouponCode: req.CouponCode, AddressID: req.AddressID}
+	for _, item := range cart.Items {
+		orderRequest.Items = append(orderRequest.Items, request.CreateOrderItemRequest{
+			ProductNumber: item.ProductNumber,
+			Quantity:      item.Quantity,
+		})
+	}
+
+	// 장바구니를 비우지 못하면 주문도 저장하지 않아, 재시도해도 같은 주문이 중복 생성되지 않도록 함
+	return ci.OrderInteractor.createOrder(orderRequest, memberNumber, func(tx *gorm.DB) error {
+		cart.Clear()
+		return ci.CartRepository.WithTx(tx).Save(cart)
+	})
+}
+
+func (ci *CartInteractor) loadCart(memberNumber string) (*domain.Cart, error) {
+	cart, err := ci.CartRepository.GetByMemberNumber(memberNumber)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &domain.Cart{MemberNumber: memberNumber}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return cart, nil
+}
+
+func (ci *CartInteractor) toCartResponse(cart *domain.Cart) (*response.CartResponse, error) {
+	products := make(map[string]*domain.Product)
+	for _, item := range cart.Items {
+		product, err := ci.ProductRepository.GetByProductNumber(item.ProductNumber)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		products[item.ProductNumber] = product
+	}
+	return response.NewCartResponse(cart, products), nil
+}
@@ -0,0 +1,420 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	domainRepository "github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/gateway"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type failingSaveCartRepository struct {
+	domainRepository.CartRepository
+}
+
+func (r *failingSaveCartRepository) WithTx(tx *gorm.DB) domainRepository.CartRepository {
+	return r
+}
+
+func (r *failingSaveCartRepository) Save(cart *domain.Cart) error {
+	return errors.New("장바구니를 저장할 수 없습니다.")
+}
+
+func TestCartInteractor_GetMyCart_Success_Empty(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	interactor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+
+	// When
+	cart, err := interactor.GetMyCart("M12345")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "M12345", cart.MemberNumber)
+	assert.Empty(t, cart.Items)
+}
+
+func TestCartInteractor_AddItem_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	interactor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 3,
+	}
+	_ = productRepo.Create(product)
+
+	// When
+	cart, err := interactor.AddItem("M12345", &request.AddCartItemRequest{ProductNumber: "P12345", Quantity: 5})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, cart.Items, 1)
+	assert.EqualValues(t, 1000, cart.Items[0].Price)
+	assert.EqualValues(t, 5000, cart.Items[0].LineTotal)
+	assert.Equal(t, 3, cart.Items[0].StockQuantity)
+	assert.False(t, cart.Items[0].IsAvailable)
+	assert.EqualValues(t, 5000, cart.TotalAmount)
+}
+
+func TestCartInteractor_AddItem_Failure_InvalidProduct(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	interactor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+
+	// When
+	cart, err := interactor.AddItem("M12345", &request.AddCartItemRequest{ProductNumber: "nonexistent", Quantity: 1})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, cart)
+	assert.Equal(t, "유효하지 않은 상품 번호입니다.", err.Error())
+}
+
+func TestCartInteractor_AddItem_Failure_ArchivedProduct(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	interactor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+
+	archivedAt := time.Now()
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 3,
+		ArchivedAt:    &archivedAt,
+	}
+	_ = productRepo.Create(product)
+
+	// When
+	cart, err := interactor.AddItem("M12345", &request.AddCartItemRequest{ProductNumber: "P12345", Quantity: 1})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, cart)
+	assert.Equal(t, "판매가 중단된 상품입니다.", err.Error())
+}
+
+func TestCartInteractor_UpdateItem_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	interactor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+	_, _ = interactor.AddItem("M12345", &request.AddCartItemRequest{ProductNumber: "P12345", Quantity: 1})
+
+	// When
+	cart, err := interactor.UpdateItem("M12345", "P12345", &request.UpdateCartItemRequest{Quantity: 4})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 4, cart.Items[0].Quantity)
+	assert.True(t, cart.Items[0].IsAvailable)
+}
+
+func TestCartInteractor_RemoveItem_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	interactor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+	_, _ = interactor.AddItem("M12345", &request.AddCartItemRequest{ProductNumber: "P12345", Quantity: 1})
+
+	// When
+	cart, err := interactor.RemoveItem("M12345", "P12345")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Empty(t, cart.Items)
+}
+
+func TestCartInteractor_Checkout_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	interactor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	product1 := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Product One",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	product2 := &domain.Product{
+		ProductNumber: "P12346",
+		ProductName:   "Product Two",
+		Price:         1500,
+		StockQuantity: 5,
+	}
+	_ = productRepo.Create(product1)
+	_ = productRepo.Create(product2)
+	_, _ = interactor.AddItem("M12345", &request.AddCartItemRequest{ProductNumber: "P12345", Quantity: 2})
+	_, _ = interactor.AddItem("M12345", &request.AddCartItemRequest{ProductNumber: "P12346", Quantity: 1})
+
+	// When
+	responseData, err := interactor.Checkout("M12345", &request.CheckoutCartRequest{})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, responseData.Order.Items, 2)
+	assert.EqualValues(t, 3500, responseData.Order.TotalAmount)
+
+	cart, _ := interactor.GetMyCart("M12345")
+	assert.Empty(t, cart.Items)
+
+	updatedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 8, updatedProduct.StockQuantity)
+}
+
+func TestCartInteractor_Checkout_Failure_EmptyCart(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	interactor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+
+	// When
+	responseData, err := interactor.Checkout("M12345", &request.CheckoutCartRequest{})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, responseData)
+	assert.Equal(t, "장바구니가 비어 있습니다.", err.Error())
+}
+
+func TestCartInteractor_Checkout_Failure_InsufficientStockKeepsCart(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	interactor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 1,
+	}
+	_ = productRepo.Create(product)
+	_, _ = interactor.AddItem("M12345", &request.AddCartItemRequest{ProductNumber: "P12345", Quantity: 2})
+
+	// When
+	responseData, err := interactor.Checkout("M12345", &request.CheckoutCartRequest{})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, responseData)
+
+	cart, _ := interactor.GetMyCart("M12345")
+	assert.Len(t, cart.Items, 1)
+}
+
+func TestCartInteractor_Checkout_Failure_CartSaveRollsBackOrder(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+	_, _ = usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor).AddItem("M12345", &request.AddCartItemRequest{ProductNumber: "P12345", Quantity: 2})
+	interactor := usecases.NewCartInteractor(&failingSaveCartRepository{CartRepository: cartRepo}, productRepo, orderInteractor)
+
+	// When
+	responseData, err := interactor.Checkout("M12345", &request.CheckoutCartRequest{})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, responseData)
+
+	orders, _ := orderRepo.GetByMemberNumber("M12345")
+	assert.Empty(t, orders)
+
+	unchangedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 10, unchangedProduct.StockQuantity)
+
+	cart, _ := interactor.GetMyCart("M12345")
+	assert.Len(t, cart.Items, 1)
+}
+
+func TestCartInteractor_Checkout_Success_WithAddressWithoutDefault(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	interactor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	address := fixtures.CreateDefaultAddress(db, "M12345")
+	db.Model(address).Update("is_default", false)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+	_, _ = interactor.AddItem("M12345", &request.AddCartItemRequest{ProductNumber: "P12345", Quantity: 2})
+
+	// When
+	responseData, err := interactor.Checkout("M12345", &request.CheckoutCartRequest{AddressID: address.ID})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, address.PostalAddress.RecipientName, responseData.Order.ShippingAddress.RecipientName)
+
+	cart, _ := interactor.GetMyCart("M12345")
+	assert.Empty(t, cart.Items)
+}
+
+func TestCartInteractor_Checkout_Failure_NoAddressKeepsCart(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	orderInteractor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+	interactor := usecases.NewCartInteractor(cartRepo, productRepo, orderInteractor)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+	_, _ = interactor.AddItem("M12345", &request.AddCartItemRequest{ProductNumber: "P12345", Quantity: 2})
+
+	// When
+	responseData, err := interactor.Checkout("M12345", &request.CheckoutCartRequest{})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, responseData)
+	assert.Equal(t, "배송지를 지정하거나 기본 배송지를 등록해 주세요.", err.Error())
+
+	cart, _ := interactor.GetMyCart("M12345")
+	assert.Len(t, cart.Items, 1)
+}
@@ -0,0 +1,115 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/response"
+	"gorm.io/gorm"
+)
+
+type CategoryInteractor struct {
+	CategoryRepository repository.CategoryRepository
+}
+
+func NewCategoryInteractor(cr repository.CategoryRepository) *CategoryInteractor {
+	return &CategoryInteractor{CategoryRepository: cr}
+}
+
+func (ci *CategoryInteractor) CreateCategory(req *request.CreateCategoryRequest) (*response.CategoryResponse, error) {
+	category, err := req.CreateToEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	if existing, _ := ci.CategoryRepository.GetBySlug(category.Slug); existing != nil {
+		return nil, errors.New("이미 존재하는 슬러그입니다.")
+	}
+	if category.ParentID != nil {
+		if _, err := ci.getParent(*category.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ci.CategoryRepository.Create(category); err != nil {
+		return nil, err
+	}
+	return response.NewCategoryResponse(category), nil
+}
+
+func (ci *CategoryInteractor) GetCategoryTree() ([]response.CategoryResponse, error) {
+	categories, err := ci.CategoryRepository.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	return response.NewCategoryTreeResponse(categories), nil
+}
+
+func (ci *CategoryInteractor) GetCategory(id int) (*response.CategoryResponse, error) {
+	category, err := ci.CategoryRepository.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+	return response.NewCategoryResponse(category), nil
+}
+
+func (ci *CategoryInteractor) UpdateCategory(id int, req *request.UpdateCategoryRequest) (*response.CategoryResponse, error) {
+	category, err := ci.CategoryRepository.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.ApplyToEntity(category); err != nil {
+		return nil, err
+	}
+
+	var parent *domain.Category
+	if req.ParentID != nil {
+		if parent, err = ci.getParent(*req.ParentID); err != nil {
+			return nil, err
+		}
+	}
+	categories, err := ci.CategoryRepository.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	if err := category.MoveTo(parent, categories); err != nil {
+		return nil, err
+	}
+
+	if err := ci.CategoryRepository.Update(category); err != nil {
+		return nil, err
+	}
+	return response.NewCategoryResponse(category), nil
+}
+
+func (ci *CategoryInteractor) DeleteCategory(id int) error {
+	if _, err := ci.CategoryRepository.GetById(id); err != nil {
+		return err
+	}
+
+	categories, err := ci.CategoryRepository.GetAll()
+	if err != nil {
+		return err
+	}
+	if len(domain.CategoryDescendantIDs(categories, id)) > 1 {
+		return errors.New("하위 카테고리가 있어 삭제할 수 없습니다.")
+	}
+	count, err := ci.CategoryRepository.CountProducts(id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.New("카테고리에 등록된 상품이 있어 삭제할 수 없습니다.")
+	}
+	return ci.CategoryRepository.Delete(id)
+}
+
+func (ci *CategoryInteractor) getParent(parentID int) (*domain.Category, error) {
+	parent, err := ci.CategoryRepository.GetById(parentID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("존재하지 않는 상위 카테고리입니다.")
+	}
+	return parent, err
+}
@@ -0,0 +1,155 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategoryInteractor_CreateCategory_Success_NormalizesSlug(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewCategoryInteractor(repository.NewCategoryRepository(db))
+
+	// When
+	responseData, err := interactor.CreateCategory(&request.CreateCategoryRequest{Name: "식품", Slug: " Food "})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "food", responseData.Slug)
+	assert.Nil(t, responseData.ParentID)
+}
+
+func TestCategoryInteractor_CreateCategory_Failure_DuplicateSlug(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewCategoryInteractor(repository.NewCategoryRepository(db))
+	_, _ = interactor.CreateCategory(&request.CreateCategoryRequest{Name: "식품", Slug: "food"})
+
+	// When
+	_, err := interactor.CreateCategory(&request.CreateCategoryRequest{Name: "음식", Slug: "FOOD"})
+
+	// Then
+	assert.EqualError(t, err, "이미 존재하는 슬러그입니다.")
+}
+
+func TestCategoryInteractor_CreateCategory_Failure_ParentNotFound(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewCategoryInteractor(repository.NewCategoryRepository(db))
+	parentID := 999
+
+	// When
+	_, err := interactor.CreateCategory(&request.CreateCategoryRequest{Name: "과자", Slug: "snacks", ParentID: &parentID})
+
+	// Then
+	assert.EqualError(t, err, "존재하지 않는 상위 카테고리입니다.")
+}
+
+func TestCategoryInteractor_GetCategoryTree_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewCategoryInteractor(repository.NewCategoryRepository(db))
+	food, _ := interactor.CreateCategory(&request.CreateCategoryRequest{Name: "식품", Slug: "food"})
+	_, _ = interactor.CreateCategory(&request.CreateCategoryRequest{Name: "음료", Slug: "drinks", ParentID: &food.ID, SortOrder: 2})
+	_, _ = interactor.CreateCategory(&request.CreateCategoryRequest{Name: "과자", Slug: "snacks", ParentID: &food.ID, SortOrder: 1})
+	_, _ = interactor.CreateCategory(&request.CreateCategoryRequest{Name: "의류", Slug: "clothing", SortOrder: 1})
+
+	// When
+	tree, err := interactor.GetCategoryTree()
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, tree, 2)
+	assert.Equal(t, "food", tree[0].Slug)
+	assert.Len(t, tree[0].Children, 2)
+	assert.Equal(t, "snacks", tree[0].Children[0].Slug)
+	assert.Equal(t, "drinks", tree[0].Children[1].Slug)
+	assert.Equal(t, "clothing", tree[1].Slug)
+	assert.Empty(t, tree[1].Children)
+}
+
+func TestCategoryInteractor_UpdateCategory_Failure_MoveUnderDescendant(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewCategoryInteractor(repository.NewCategoryRepository(db))
+	food, _ := interactor.CreateCategory(&request.CreateCategoryRequest{Name: "식품", Slug: "food"})
+	snacks, _ := interactor.CreateCategory(&request.CreateCategoryRequest{Name: "과자", Slug: "snacks", ParentID: &food.ID})
+
+	// When
+	_, err := interactor.UpdateCategory(food.ID, &request.UpdateCategoryRequest{Name: "식품", ParentID: &snacks.ID})
+
+	// Then
+	assert.EqualError(t, err, "하위 카테고리 아래로 이동할 수 없습니다.")
+}
+
+func TestCategoryInteractor_UpdateCategory_Success_MoveToRoot(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewCategoryInteractor(repository.NewCategoryRepository(db))
+	food, _ := interactor.CreateCategory(&request.CreateCategoryRequest{Name: "식품", Slug: "food"})
+	snacks, _ := interactor.CreateCategory(&request.CreateCategoryRequest{Name: "과자", Slug: "snacks", ParentID: &food.ID})
+
+	// When
+	responseData, err := interactor.UpdateCategory(snacks.ID, &request.UpdateCategoryRequest{Name: "스낵", SortOrder: 3})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Nil(t, responseData.ParentID)
+	assert.Equal(t, "스낵", responseData.Name)
+	assert.Equal(t, "snacks", responseData.Slug)
+	assert.Equal(t, 3, responseData.SortOrder)
+}
+
+func TestCategoryInteractor_DeleteCategory_Failure_HasChildren(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewCategoryInteractor(repository.NewCategoryRepository(db))
+	food, _ := interactor.CreateCategory(&request.CreateCategoryRequest{Name: "식품", Slug: "food"})
+	_, _ = interactor.CreateCategory(&request.CreateCategoryRequest{Name: "과자", Slug: "snacks", ParentID: &food.ID})
+
+	// When
+	err := interactor.DeleteCategory(food.ID)
+
+	// Then
+	assert.EqualError(t, err, "하위 카테고리가 있어 삭제할 수 없습니다.")
+}
+
+func TestCategoryInteractor_DeleteCategory_Failure_HasProducts(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	categoryRepo := repository.NewCategoryRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewCategoryInteractor(categoryRepo)
+	food, _ := interactor.CreateCategory(&request.CreateCategoryRequest{Name: "식품", Slug: "food"})
+	category, _ := categoryRepo.GetById(food.ID)
+	product := &domain.Product{ProductNumber: "P12345", ProductName: "Pizza", Price: 1000}
+	product.AssignCategory(category)
+	_ = productRepo.Create(product)
+
+	// When
+	err := interactor.DeleteCategory(food.ID)
+
+	// Then
+	assert.EqualError(t, err, "카테고리에 등록된 상품이 있어 삭제할 수 없습니다.")
+}
+
+func TestCategoryInteractor_DeleteCategory_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewCategoryInteractor(repository.NewCategoryRepository(db))
+	food, _ := interactor.CreateCategory(&request.CreateCategoryRequest{Name: "식품", Slug: "food"})
+
+	// When
+	err := interactor.DeleteCategory(food.ID)
+
+	// Then
+	assert.NoError(t, err)
+	_, err = interactor.GetCategory(food.ID)
+	assert.Error(t, err)
+}
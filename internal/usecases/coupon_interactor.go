@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/response"
+)
+
+type CouponInteractor struct {
+	CouponRepository repository.CouponRepository
+}
+
+func NewCouponInteractor(cr repository.CouponRepository) *CouponInteractor {
+	return &CouponInteractor{CouponRepository: cr}
+}
+
+func (ci *CouponInteractor) CreateCoupon(req *request.CreateCouponRequest) (*response.CouponResponse, error) {
+	coupon, err := req.CreateToEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	if existing, _ := ci.CouponRepository.GetByCode(coupon.Code); existing != nil {
+		return nil, errors.New("이미 존재하는 쿠폰 코드입니다.")
+	}
+
+	if err := ci.CouponRepository.Create(coupon); err != nil {
+		return nil, err
+	}
+	return response.NewCouponResponse(coupon), nil
+}
+
+func (ci *CouponInteractor) GetCoupons() ([]response.CouponResponse, error) {
+	coupons, err := ci.CouponRepository.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	couponResponses := make([]response.CouponResponse, 0, len(coupons))
+	for _, coupon := range coupons {
+		couponResponses = append(couponResponses, *response.NewCouponResponse(coupon))
+	}
+	return couponResponses, nil
+}
+
+func (ci *CouponInteractor) GetCoupon(id int) (*response.CouponResponse, error) {
+	coupon, err := ci.CouponRepository.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+	return response.NewCouponResponse(coupon), nil
+}
+
+func (ci *CouponInteractor) UpdateCoupon(id int, req *request.UpdateCouponRequest) (*response.CouponResponse, error) {
+	coupon, err := ci.CouponRepository.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.ApplyToEntity(coupon); err != nil {
+		return nil, err
+	}
+	if err := ci.CouponRepository.Update(coupon); err != nil {
+		return nil, err
+	}
+	return response.NewCouponResponse(coupon), nil
+}
+
+func (ci *CouponInteractor) DeleteCoupon(id int) error {
+	coupon, err := ci.CouponRepository.GetById(id)
+	if err != nil {
+		return err
+	}
+	if coupon.UsedCount > 0 {
+		return errors.New("사용 이력이 있는 쿠폰은 삭제할 수 없습니다.")
+	}
+	return ci.CouponRepository.Delete(id)
+}
@@ -0,0 +1,110 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCreateCouponRequest() *request.CreateCouponRequest {
+	return &request.CreateCouponRequest{
+		Code:          "WELCOME10",
+		Name:          "신규 회원 10% 할인",
+		DiscountType:  "percentage",
+		DiscountValue: 10,
+		StartsAt:      time.Now().Add(-time.Hour),
+		EndsAt:        time.Now().Add(time.Hour),
+	}
+}
+
+func TestCouponInteractor_CreateCoupon_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	couponRepo := repository.NewCouponRepository(db)
+	interactor := usecases.NewCouponInteractor(couponRepo)
+
+	// When
+	responseData, err := interactor.CreateCoupon(newCreateCouponRequest())
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "WELCOME10", responseData.Code)
+	assert.Equal(t, "percentage", responseData.DiscountType)
+}
+
+func TestCouponInteractor_CreateCoupon_Failure_DuplicateCode(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	couponRepo := repository.NewCouponRepository(db)
+	interactor := usecases.NewCouponInteractor(couponRepo)
+	_, _ = interactor.CreateCoupon(newCreateCouponRequest())
+
+	// When
+	_, err := interactor.CreateCoupon(newCreateCouponRequest())
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "이미 존재하는 쿠폰 코드입니다.", err.Error())
+}
+
+func TestCouponInteractor_UpdateCoupon_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	couponRepo := repository.NewCouponRepository(db)
+	interactor := usecases.NewCouponInteractor(couponRepo)
+	created, _ := interactor.CreateCoupon(newCreateCouponRequest())
+
+	// When
+	responseData, err := interactor.UpdateCoupon(created.ID, &request.UpdateCouponRequest{
+		Name:          "정액 할인",
+		DiscountType:  "fixed",
+		DiscountValue: 3000,
+		StartsAt:      time.Now().Add(-time.Hour),
+		EndsAt:        time.Now().Add(time.Hour),
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "WELCOME10", responseData.Code)
+	assert.Equal(t, "fixed", responseData.DiscountType)
+	assert.EqualValues(t, 3000, responseData.DiscountValue)
+}
+
+func TestCouponInteractor_DeleteCoupon_Failure_Used(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	couponRepo := repository.NewCouponRepository(db)
+	interactor := usecases.NewCouponInteractor(couponRepo)
+	created, _ := interactor.CreateCoupon(newCreateCouponRequest())
+	coupon, _ := couponRepo.GetById(created.ID)
+	_ = couponRepo.Use(coupon, &domain.CouponUsage{MemberNumber: "M12345", OrderID: 1, DiscountAmount: 100, UsedAt: time.Now()})
+
+	// When
+	err := interactor.DeleteCoupon(created.ID)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "사용 이력이 있는 쿠폰은 삭제할 수 없습니다.", err.Error())
+}
+
+func TestCouponInteractor_DeleteCoupon_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	couponRepo := repository.NewCouponRepository(db)
+	interactor := usecases.NewCouponInteractor(couponRepo)
+	created, _ := interactor.CreateCoupon(newCreateCouponRequest())
+
+	// When
+	err := interactor.DeleteCoupon(created.ID)
+
+	// Then
+	assert.NoError(t, err)
+	_, err = interactor.GetCoupon(created.ID)
+	assert.Error(t, err)
+}
@@ -2,54 +2,109 @@ package usecases
 
 import (
 	"errors"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/domain/gateway"
 	"github.com/HongJungWan/commerce-system/internal/domain/repository"
 	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
 	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/response"
+	"gorm.io/gorm"
 )
 
 type OrderInteractor struct {
-	OrderRepository   repository.OrderRepository
-	MemberRepository  repository.MemberRepository
-	ProductRepository repository.ProductRepository
+	OrderRepository    repository.OrderRepository
+	MemberRepository   repository.MemberRepository
+	ProductRepository  repository.ProductRepository
+	CouponRepository   repository.CouponRepository
+	AddressRepository  repository.AddressRepository
+	CategoryRepository repository.CategoryRepository
+	PricingEngine      PricingEngine
+	PaymentGateway     gateway.PaymentGateway
+	// 회원 취소에 적용할 정책 (nil이면 배송 전까지 언제든 취소 가능)
+	CancellationPolicy *domain.CancellationPolicy
+	DB                 *gorm.DB
 }
 
-func NewOrderInteractor(or repository.OrderRepository, mr repository.MemberRepository, pr repository.ProductRepository) *OrderInteractor {
+func NewOrderInteractor(or repository.OrderRepository, mr repository.MemberRepository, pr repository.ProductRepository, cr repository.CouponRepository, ar repository.AddressRepository, cgr repository.CategoryRepository, pe PricingEngine, pg gateway.PaymentGateway, cp *domain.CancellationPolicy, db *gorm.DB) *OrderInteractor {
 	return &OrderInteractor{
-		OrderRepository:   or,
-		MemberRepository:  mr,
-		ProductRepository: pr,
+		OrderRepository:    or,
+		MemberRepository:   mr,
+		ProductRepository:  pr,
+		CouponRepository:   cr,
+		AddressRepository:  ar,
+		CategoryRepository: cgr,
+		PricingEngine:      pe,
+		PaymentGateway:     pg,
+		CancellationPolicy: cp,
+		DB:                 db,
 	}
 }
 
 func (oi *OrderInteractor) CreateOrder(req *request.CreateOrderRequest, memberNumber string) (*response.CreateOrderResponse, error) {
+	return oi.createOrder(req, memberNumber, nil)
+}
+
+// createOrder 는 주문을 생성하며, afterCreate 가 있으면 주문 생성과 같은 트랜잭션에서 실행합니다.
+func (oi *OrderInteractor) createOrder(req *request.CreateOrderRequest, memberNumber string, afterCreate func(tx *gorm.DB) error) (*response.CreateOrderResponse, error) {
 	order, err := req.CreateToEntity(memberNumber)
 	if err != nil {
 		return nil, err
 	}
 
-	member, err := oi.MemberRepository.GetByMemberNumber(memberNumber)
-	if err != nil || member == nil {
-		return nil, errors.New("유효하지 않은 회원 번호입니다.")
+	if err := oi.assignShippingAddress(order, req.AddressID); err != nil {
+		return nil, err
 	}
-
-	product, err := oi.ProductRepository.GetByProductNumber(order.ProductNumber)
-	if err != nil || product == nil {
-		return nil, errors.New("유효하지 않은 상품 번호입니다.")
+	// 견적은 배송지 없이도 조회할 수 있지만, 주문은 배송지가 있어야 생성
+	if order.ShippingAddress.IsEmpty() {
+		return nil, errors.New("배송지를 지정하거나 기본 배송지를 등록해 주세요.")
 	}
 
-	if product.StockQuantity < order.Quantity {
-		return nil, errors.New("재고 수량이 부족합니다.")
+	input, err := oi.buildPricingInput(order, req.CouponCode)
+	if err != nil {
+		return nil, err
 	}
-	product.StockQuantity -= order.Quantity
-	if err := oi.ProductRepository.Update(product); err != nil {
+	breakdown, err := oi.PricingEngine.Calculate(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := order.ApplyPriceBreakdown(breakdown); err != nil {
 		return nil, err
 	}
 
-	order.Price = product.Price
-	order.TotalAmount = product.Price * int64(order.Quantity)
-	order.IsCanceled = false
+	// 결제 승인은 외부 호출이므로 트랜잭션 밖에서 먼저 처리
+	paymentAmount := order.PaymentAmount()
+	transactionID, err := oi.PaymentGateway.Authorize(order.OrderNumber, paymentAmount)
+	if err != nil {
+		return nil, err
+	}
+	// 승인만 된 주문은 결제 대기 상태로 두고, 매입 시 결제 완료로 변경
+	order.Payment = domain.NewAuthorizedPayment(oi.PaymentGateway.Provider(), transactionID, paymentAmount)
 
-	if err := oi.OrderRepository.Create(order); err != nil {
+	// 재고 차감, 쿠폰 사용, 주문 생성을 하나의 트랜잭션으로 처리
+	err = oi.DB.Transaction(func(tx *gorm.DB) error {
+		productRepo := oi.ProductRepository.WithTx(tx)
+		for _, item := range order.Items {
+			if err := moveStock(productRepo, item.ProductNumber, domain.StockMovementOrder, -item.Quantity, memberNumber, order.OrderNumber); err != nil {
+				return err
+			}
+		}
+		if err := oi.OrderRepository.WithTx(tx).Create(order); err != nil {
+			return err
+		}
+		if input.Coupon != nil {
+			if err := oi.useCoupon(oi.CouponRepository.WithTx(tx), input.Coupon, order); err != nil {
+				return err
+			}
+		}
+		if afterCreate != nil {
+			return afterCreate(tx)
+		}
+		return nil
+	})
+	if err != nil {
+		// 주문이 저장되지 않았으므로 매입 전인 승인을 취소
+		_ = oi.PaymentGateway.Void(transactionID, paymentAmount)
 		return nil, err
 	}
 
@@ -61,46 +116,416 @@ func (oi *OrderInteractor) CreateOrder(req *request.CreateOrderRequest, memberNu
 	}, nil
 }
 
-func (oi *OrderInteractor) GetMyOrders(memberNumber string) ([]response.OrderResponse, error) {
-	orders, err := oi.OrderRepository.GetByMemberNumber(memberNumber)
+func (oi *OrderInteractor) QuoteOrder(req *request.CreateOrderRequest, memberNumber string) (*response.PriceBreakdownResponse, error) {
+	order, err := req.CreateToEntity(memberNumber)
 	if err != nil {
 		return nil, err
 	}
+	if err := oi.assignShippingAddress(order, req.AddressID); err != nil {
+		return nil, err
+	}
 
-	var orderResponses []response.OrderResponse
-	for _, order := range orders {
-		orderResponses = append(orderResponses, *response.NewOrderResponse(order))
+	input, err := oi.buildPricingInput(order, req.CouponCode)
+	if err != nil {
+		return nil, err
+	}
+	breakdown, err := oi.PricingEngine.Calculate(input)
+	if err != nil {
+		return nil, err
 	}
 
-	return orderResponses, nil
+	return response.NewPriceBreakdownResponse(breakdown), nil
 }
 
-func (oi *OrderInteractor) CancelOrder(orderId int, memberNumber string) error {
+func (oi *OrderInteractor) GetMyOrders(query *domain.OrderQuery) (*response.OrderListResponse, error) {
+	orders, hasNext, err := oi.OrderRepository.Search(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.NewOrderListResponse(orders, hasNext), nil
+}
+
+func (oi *OrderInteractor) GetOrder(orderId int, memberNumber string, isAdmin bool) (*response.OrderResponse, error) {
 	order, err := oi.OrderRepository.GetById(orderId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("주문을 찾을 수 없습니다.")
+		}
+		return nil, err
+	}
+
+	if !isAdmin && order.MemberNumber != memberNumber {
+		return nil, errors.New("해당 주문에 대한 권한이 없습니다.")
+	}
+
+	return response.NewOrderResponse(order), nil
+}
+
+func (oi *OrderInteractor) SearchOrders(criteria *domain.OrderSearchCriteria) (*response.OrderPageResponse, error) {
+	orders, totalCount, err := oi.OrderRepository.SearchByCriteria(criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.NewOrderPageResponse(orders, criteria.CurrentPage(), criteria.PageSize(), totalCount), nil
+}
+
+func (oi *OrderInteractor) GetOrderByNumber(orderNumber string) (*response.OrderResponse, error) {
+	order, err := oi.OrderRepository.GetByOrderNumber(orderNumber)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("주문을 찾을 수 없습니다.")
+		}
+		return nil, err
+	}
+
+	return response.NewOrderResponse(order), nil
+}
+
+func (oi *OrderInteractor) CancelOrder(orderId int, memberNumber string) error {
+	return oi.cancelOrder(orderId, func(tx *gorm.DB, order *domain.Order) error {
+		if order.MemberNumber != memberNumber {
+			return errors.New("해당 주문에 대한 권한이 없습니다.")
+		}
+		productNumbers := make([]string, 0, len(order.Items))
+		for _, item := range order.Items {
+			productNumbers = append(productNumbers, item.ProductNumber)
+		}
+		if err := oi.checkCancellationPolicy(tx, order, productNumbers...); err != nil {
+			return err
+		}
+		return order.Cancel()
+	})
+}
+
+func (oi *OrderInteractor) AdminCancelOrder(orderNumber string, adminNumber string, req *request.AdminCancelOrderRequest) (*response.OrderResponse, error) {
+	reason, err := domain.ParseCancelReason(req.ReasonCode)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := oi.OrderRepository.GetByOrderNumber(orderNumber)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("주문을 찾을 수 없습니다.")
+		}
+		return nil, err
+	}
+
+	if err := oi.cancelOrder(order.ID, func(_ *gorm.DB, order *domain.Order) error {
+		return order.CancelByAdmin(adminNumber, reason, req.Note)
+	}); err != nil {
+		return nil, err
+	}
+
+	canceledOrder, err := oi.OrderRepository.GetById(order.ID)
+	if err != nil {
+		return nil, err
+	}
+	return response.NewOrderResponse(canceledOrder), nil
+}
+
+// cancelOrder 는 잠금을 건 주문에 취소를 적용한 뒤 재고, 결제, 쿠폰을 함께 복원합니다.
+func (oi *OrderInteractor) cancelOrder(orderId int, cancel func(tx *gorm.DB, order *domain.Order) error) error {
+	var payment *domain.Payment
+	var refundAmount int64
+	err := oi.DB.Transaction(func(tx *gorm.DB) error {
+		orderRepo := oi.OrderRepository.WithTx(tx)
+		productRepo := oi.ProductRepository.WithTx(tx)
+
+		order, err := orderRepo.GetByIdForUpdate(orderId)
+		if err != nil {
+			return err
+		}
+
+		canceledFrom := len(order.Cancellations)
+		if err := cancel(tx, order); err != nil {
+			return err
+		}
+
+		// 이번 취소로 기록된 수량만 재고로 복원
+		for _, cancellation := range order.Cancellations[canceledFrom:] {
+			if err := moveStock(productRepo, cancellation.ProductNumber, domain.StockMovementCancel, cancellation.Quantity, order.CanceledBy, order.OrderNumber); err != nil {
+				return err
+			}
+		}
+
+		if order.Payment != nil {
+			payment, refundAmount = order.Payment, order.Payment.RefundableAmount()
+			if err := refundPayment(payment, refundAmount); err != nil {
+				return err
+			}
+		}
+
+		// 전체 취소된 주문의 쿠폰은 다시 사용할 수 있도록 복원
+		if order.CouponCode != "" {
+			if err := oi.CouponRepository.WithTx(tx).ReleaseUsage(order.ID); err != nil {
+				return err
+			}
+		}
+
+		return orderRepo.Update(order)
+	})
 	if err != nil {
 		return err
 	}
+	return settleRefund(oi.PaymentGateway, oi.OrderRepository, payment, refundAmount)
+}
+
+func (oi *OrderInteractor) PartialCancelOrder(orderId int, memberNumber string, req *request.PartialCancelOrderRequest) (*response.OrderResponse, error) {
+	var order *domain.Order
+	var refundAmount int64
+	err := oi.DB.Transaction(func(tx *gorm.DB) error {
+		orderRepo := oi.OrderRepository.WithTx(tx)
+		productRepo := oi.ProductRepository.WithTx(tx)
+
+		var err error
+		order, err = orderRepo.GetByIdForUpdate(orderId)
+		if err != nil {
+			return err
+		}
+
+		if order.MemberNumber != memberNumber {
+			return errors.New("해당 주문에 대한 권한이 없습니다.")
+		}
+
+		if err := oi.checkCancellationPolicy(tx, order, req.ProductNumber); err != nil {
+			return err
+		}
+
+		cancellation, err := order.PartialCancel(req.ProductNumber, req.Quantity)
+		if err != nil {
+			return err
+		}
+
+		if err := moveStock(productRepo, cancellation.ProductNumber, domain.StockMovementCancel, cancellation.Quantity, memberNumber, order.OrderNumber); err != nil {
+			return err
+		}
+
+		if order.Payment != nil {
+			// 마지막 상품까지 취소되면 남은 결제 금액을 모두 환불
+			refundAmount = order.RefundAmountFor(cancellation.ProductNumber, cancellation.Quantity)
+			if order.IsCanceled() {
+				refundAmount = order.Payment.RefundableAmount()
+			}
+			if err := refundPayment(order.Payment, refundAmount); err != nil {
+				return err
+			}
+		}
+
+		// 마지막 상품까지 취소되어 전체 취소된 주문의 쿠폰은 다시 사용할 수 있도록 복원
+		if order.IsCanceled() && order.CouponCode != "" {
+			if err := oi.CouponRepository.WithTx(tx).ReleaseUsage(order.ID); err != nil {
+				return err
+			}
+		}
+
+		return orderRepo.Update(order)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := settleRefund(oi.PaymentGateway, oi.OrderRepository, order.Payment, refundAmount); err != nil {
+		return nil, err
+	}
 
-	if order.MemberNumber != memberNumber {
-		return errors.New("해당 주문에 대한 권한이 없습니다.")
+	return response.NewOrderResponse(order), nil
+}
+
+func (oi *OrderInteractor) ChangeOrderStatus(orderId int, req *request.UpdateOrderStatusRequest) (*response.OrderResponse, error) {
+	status, err := domain.ParseOrderStatus(req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	var order *domain.Order
+	err = oi.DB.Transaction(func(tx *gorm.DB) error {
+		orderRepo := oi.OrderRepository.WithTx(tx)
+
+		order, err = orderRepo.GetByIdForUpdate(orderId)
+		if err != nil {
+			return err
+		}
+		// 결제 완료나 배송 시작으로 변경하면 승인된 결제를 먼저 매입
+		if order.Status == domain.OrderStatusPending && (status == domain.OrderStatusPaid || status == domain.OrderStatusShipped) {
+			if err := payOrder(oi.PaymentGateway, order); err != nil {
+				return err
+			}
+			if status == domain.OrderStatusPaid {
+				return orderRepo.Update(order)
+			}
+		}
+		if err := order.ChangeStatus(status); err != nil {
+			return err
+		}
+		return orderRepo.Update(order)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if err := order.Cancel(); err != nil {
+	return response.NewOrderResponse(order), nil
+}
+
+func (oi *OrderInteractor) GetMonthlyStats(month string) (*domain.OrderStats, error) {
+	return oi.OrderRepository.GetMonthlyStats(month)
+}
+
+// checkCancellationPolicy 는 취소할 상품의 카테고리와 상위 카테고리를 조회해 회원 취소 정책을 확인합니다.
+func (oi *OrderInteractor) checkCancellationPolicy(tx *gorm.DB, order *domain.Order, productNumbers ...string) error {
+	if oi.CancellationPolicy == nil {
+		return nil
+	}
+
+	productRepo := oi.ProductRepository.WithTx(tx)
+	allCategories, err := oi.CategoryRepository.WithTx(tx).GetAll()
+	if err != nil {
 		return err
 	}
 
-	product, err := oi.ProductRepository.GetByProductNumber(order.ProductNumber)
+	categories := make([][]string, 0, len(productNumbers))
+	for _, productNumber := range productNumbers {
+		product, err := productRepo.GetByProductNumber(productNumber)
+		if err != nil {
+			// 삭제된 상품은 카테고리별 정책 없이 기본 정책만 적용
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return err
+		}
+		categories = append(categories, domain.CategoryAncestorSlugs(allCategories, product.Category))
+	}
+
+	return oi.CancellationPolicy.Check(order, categories, time.Now())
+}
+
+// 배송지를 지정하지 않으면 기본 배송지를 사용
+func (oi *OrderInteractor) assignShippingAddress(order *domain.Order, addressID int) error {
+	if addressID == 0 {
+		address, err := oi.AddressRepository.GetDefault(order.MemberNumber)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		order.AssignShippingAddress(address)
+		return nil
+	}
+
+	address, err := oi.AddressRepository.GetById(addressID)
+	if err != nil || !address.IsOwnedBy(order.MemberNumber) {
+		return errors.New("유효하지 않은 배송지입니다.")
+	}
+	order.AssignShippingAddress(address)
+	return nil
+}
+
+func (oi *OrderInteractor) buildPricingInput(order *domain.Order, couponCode string) (*PricingInput, error) {
+	member, err := oi.MemberRepository.GetByMemberNumber(order.MemberNumber)
+	if err != nil || member == nil {
+		return nil, errors.New("유효하지 않은 회원 번호입니다.")
+	}
+
+	categories, err := oi.CategoryRepository.GetAll()
 	if err != nil {
+		return nil, err
+	}
+
+	input := &PricingInput{Member: member}
+	if !order.ShippingAddress.IsEmpty() {
+		input.ShippingAddress = &order.ShippingAddress
+	}
+	for _, item := range order.Items {
+		product, err := oi.ProductRepository.GetByProductNumber(item.ProductNumber)
+		if err != nil || product == nil {
+			return nil, errors.New("유효하지 않은 상품 번호입니다.")
+		}
+		if err := checkOrderable(oi.ProductRepository, product); err != nil {
+			return nil, err
+		}
+		input.Items = append(input.Items, PricingItem{
+			Product:            product,
+			Quantity:           item.Quantity,
+			AncestorCategories: domain.CategoryAncestorSlugs(categories, product.Category)[1:],
+		})
+	}
+
+	if couponCode != "" {
+		coupon, err := oi.CouponRepository.GetByCode(couponCode)
+		if err != nil {
+			return nil, errors.New("유효하지 않은 쿠폰 코드입니다.")
+		}
+		input.Coupon = coupon
+	}
+	return input, nil
+}
+
+func (oi *OrderInteractor) useCoupon(couponRepo repository.CouponRepository, coupon *domain.Coupon, order *domain.Order) error {
+	if coupon.PerMemberLimit > 0 {
+		// 같은 회원의 동시 주문이 사용 횟수를 함께 확인하지 않도록 쿠폰 행을 잠근 뒤 집계
+		if _, err := couponRepo.GetByIdForUpdate(coupon.ID); err != nil {
+			return err
+		}
+		used, err := couponRepo.CountUsageByMember(coupon.ID, order.MemberNumber)
+		if err != nil {
+			return err
+		}
+		if used >= int64(coupon.PerMemberLimit) {
+			return errors.New("회원별 쿠폰 사용 한도를 초과했습니다.")
+		}
+	}
+	return couponRepo.Use(coupon, &domain.CouponUsage{
+		MemberNumber:   order.MemberNumber,
+		OrderID:        order.ID,
+		DiscountAmount: order.DiscountAmount,
+		UsedAt:         time.Now(),
+	})
+}
+
+// payOrder 는 승인된 결제를 매입하고 주문을 결제 완료로 변경합니다.
+func payOrder(paymentGateway gateway.PaymentGateway, order *domain.Order) error {
+	if err := order.MarkPaid(); err != nil {
 		return err
 	}
-	product.StockQuantity += order.Quantity
-	if err := oi.ProductRepository.Update(product); err != nil {
+	if order.Payment == nil {
+		return nil
+	}
+	return capturePayment(paymentGateway, order.Payment)
+}
+
+// capturePayment 는 부분 취소로 환불된 금액을 제외한 나머지 결제 금액을 매입합니다.
+func capturePayment(paymentGateway gateway.PaymentGateway, payment *domain.Payment) error {
+	if err := payment.Capture(); err != nil {
 		return err
 	}
+	return paymentGateway.Capture(payment.TransactionID, payment.RefundableAmount())
+}
 
-	return oi.OrderRepository.Update(order)
+// refundPayment 는 환불을 결제 정보에만 기록하며, 대행사 반영은 트랜잭션 커밋 후 settleRefund 로 처리합니다.
+func refundPayment(payment *domain.Payment, amount int64) error {
+	if amount <= 0 {
+		return nil
+	}
+	return payment.Refund(amount)
 }
 
-func (oi *OrderInteractor) GetMonthlyStats(month string) (int64, int64, error) {
-	return oi.OrderRepository.GetMonthlyStats(month)
+// settleRefund 는 커밋된 환불을 대행사에 반영합니다. 매입 전이면 승인을 취소하고, 매입 후면 환불합니다.
+// 롤백된 트랜잭션이 이미 나간 환불을 되돌리지 않도록 커밋 후에 호출하며, 실패하면 대기 금액이 결제 정보에 남습니다.
+func settleRefund(paymentGateway gateway.PaymentGateway, orderRepo repository.OrderRepository, payment *domain.Payment, amount int64) error {
+	if payment == nil || amount <= 0 {
+		return nil
+	}
+	reverse := paymentGateway.Refund
+	if !payment.IsCaptured() {
+		reverse = paymentGateway.Void
+	}
+	if err := reverse(payment.TransactionID, amount); err != nil {
+		return err
+	}
+	if err := payment.CompleteRefund(amount); err != nil {
+		return err
+	}
+	return orderRepo.CompletePaymentRefund(payment.ID, amount)
 }
@@ -1,10 +1,15 @@
 package usecases_test
 
 import (
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/HongJungWan/commerce-system/internal/domain"
+	domainRepository "github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/gateway"
 	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
 	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
 	"github.com/HongJungWan/commerce-system/internal/usecases"
@@ -12,13 +17,42 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+type failingDefaultAddressRepository struct {
+	domainRepository.AddressRepository
+}
+
+func (r *failingDefaultAddressRepository) GetDefault(memberNumber string) (*domain.Address, error) {
+	return nil, errors.New("배송지를 조회할 수 없습니다.")
+}
+
+// 승인 취소와 환불 호출을 기록하는 결제 대행사
+type recordingPaymentGateway struct {
+	*gateway.FakePaymentGateway
+	voidedAmount   int64
+	refundedAmount int64
+	failVoid       bool
+}
+
+func (g *recordingPaymentGateway) Void(transactionID string, amount int64) error {
+	if g.failVoid {
+		return errors.New("결제 대행사 응답이 없습니다.")
+	}
+	g.voidedAmount += amount
+	return g.FakePaymentGateway.Void(transactionID, amount)
+}
+
+func (g *recordingPaymentGateway) Refund(transactionID string, amount int64) error {
+	g.refundedAmount += amount
+	return g.FakePaymentGateway.Refund(transactionID, amount)
+}
+
 func TestOrderInteractor_CreateOrder_Failure_InvalidMember(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	orderRepo := repository.NewOrderRepository(db)
 	memberRepo := repository.NewMemberRepository(db)
 	productRepo := repository.NewProductRepository(db)
-	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
 
 	product := &domain.Product{
 		ProductNumber: "P12345",
@@ -29,8 +63,9 @@ func TestOrderInteractor_CreateOrder_Failure_InvalidMember(t *testing.T) {
 	_ = productRepo.Create(product)
 
 	req := &request.CreateOrderRequest{
-		ProductNumber: "P12345",
-		Quantity:      2,
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 2},
+		},
 	}
 
 	// When
@@ -48,7 +83,7 @@ func TestOrderInteractor_CreateOrder_Failure_InvalidProduct(t *testing.T) {
 	orderRepo := repository.NewOrderRepository(db)
 	memberRepo := repository.NewMemberRepository(db)
 	productRepo := repository.NewProductRepository(db)
-	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
 
 	member := &domain.Member{
 		MemberNumber: "M12345",
@@ -58,10 +93,12 @@ func TestOrderInteractor_CreateOrder_Failure_InvalidProduct(t *testing.T) {
 	}
 	member.AssignPassword("password123")
 	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
 
 	req := &request.CreateOrderRequest{
-		ProductNumber: "",
-		Quantity:      2,
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "", Quantity: 2},
+		},
 	}
 
 	// When
@@ -73,13 +110,13 @@ func TestOrderInteractor_CreateOrder_Failure_InvalidProduct(t *testing.T) {
 	assert.Equal(t, "상품번호가 누락되었습니다.", err.Error())
 }
 
-func TestOrderInteractor_CancelOrder_Success(t *testing.T) {
+func TestOrderInteractor_CreateOrder_Failure_ArchivedProduct(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	orderRepo := repository.NewOrderRepository(db)
 	memberRepo := repository.NewMemberRepository(db)
 	productRepo := repository.NewProductRepository(db)
-	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
 
 	member := &domain.Member{
 		MemberNumber: "M12345",
@@ -89,63 +126,227 @@ func TestOrderInteractor_CancelOrder_Success(t *testing.T) {
 	}
 	member.AssignPassword("password123")
 	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
 
+	archivedAt := time.Now()
 	product := &domain.Product{
 		ProductNumber: "P12345",
 		ProductName:   "Test Product",
 		Price:         1000,
 		StockQuantity: 10,
+		ArchivedAt:    &archivedAt,
 	}
 	_ = productRepo.Create(product)
 
-	order := &domain.Order{
-		ID:            12345,
-		OrderNumber:   "O12345",
-		OrderDate:     time.Now(),
-		MemberNumber:  "M12345",
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 2},
+		},
+	}
+
+	// When
+	responseData, err := interactor.CreateOrder(req, "M12345")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, responseData)
+	assert.Equal(t, "판매가 중단된 상품입니다.", err.Error())
+	unchangedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 10, unchangedProduct.StockQuantity)
+}
+
+func TestOrderInteractor_CreateOrder_Success_Variant(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	parent := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "T-Shirt",
+		Price:         10000,
+		Options:       []domain.ProductOption{{Name: "size", Values: []string{"S", "M"}}},
+	}
+	variant, _ := parent.NewVariant("P12346", "TSHIRT-M", map[string]string{"size": "M"}, nil, 5)
+	_ = productRepo.Create(parent)
+	_ = productRepo.Create(variant)
+	_, _ = parent.ChangePrice(11000, "ADMIN1")
+	_ = productRepo.Update(parent)
+
+	// When
+	responseData, err := interactor.CreateOrder(&request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{{ProductNumber: "P12346", Quantity: 2}},
+	}, "M12345")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "P12346", responseData.Order.Items[0].ProductNumber)
+	assert.Equal(t, "T-Shirt (M)", responseData.Order.Items[0].ProductName)
+	assert.Equal(t, "TSHIRT-M", responseData.Order.Items[0].SKU)
+	assert.Equal(t, map[string]string{"size": "M"}, responseData.Order.Items[0].OptionValues)
+	assert.Equal(t, int64(11000), responseData.Order.Items[0].Price)
+	updatedVariant, _ := productRepo.GetByProductNumber("P12346")
+	assert.Equal(t, 3, updatedVariant.StockQuantity)
+
+	_, err = interactor.CreateOrder(&request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{{ProductNumber: "P12345", Quantity: 1}},
+	}, "M12345")
+	assert.EqualError(t, err, "옵션 상품을 선택해 주세요.")
+}
+
+func TestOrderInteractor_CreateOrder_Success_MultipleItems(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	product1 := &domain.Product{
 		ProductNumber: "P12345",
+		ProductName:   "Product One",
 		Price:         1000,
-		Quantity:      2,
-		TotalAmount:   2000,
-		IsCanceled:    false,
+		StockQuantity: 10,
+	}
+	product2 := &domain.Product{
+		ProductNumber: "P12346",
+		ProductName:   "Product Two",
+		Price:         1500,
+		StockQuantity: 5,
+	}
+	_ = productRepo.Create(product1)
+	_ = productRepo.Create(product2)
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 2},
+			{ProductNumber: "P12346", Quantity: 1},
+			{ProductNumber: "P12345", Quantity: 1},
+		},
 	}
-	_ = orderRepo.Create(order)
 
 	// When
-	err := interactor.CancelOrder(12345, "M12345")
+	responseData, err := interactor.CreateOrder(req, "M12345")
 
 	// Then
 	assert.NoError(t, err)
+	assert.Equal(t, "주문이 등록되었습니다.", responseData.Message)
+	assert.Len(t, responseData.Order.Items, 2)
+	assert.EqualValues(t, 4500, responseData.Order.TotalAmount)
+	assert.Equal(t, "pending", responseData.Order.Status)
+	assert.Equal(t, "authorized", responseData.Order.Payment.Status)
+	assert.EqualValues(t, 4500, responseData.Order.Payment.Amount)
 
-	updatedOrder, _ := orderRepo.GetByOrderNumber("O12345")
-	assert.True(t, updatedOrder.IsCanceled)
+	savedOrder, _ := orderRepo.GetByOrderNumber(responseData.Order.OrderNumber)
+	assert.Len(t, savedOrder.Items, 2)
+	assert.Equal(t, 3, savedOrder.Items[0].Quantity)
+	assert.EqualValues(t, 3000, savedOrder.Items[0].LineTotal)
 
-	updatedProduct, _ := productRepo.GetByProductNumber("P12345")
-	assert.Equal(t, 12, updatedProduct.StockQuantity)
+	updatedProduct1, _ := productRepo.GetByProductNumber("P12345")
+	updatedProduct2, _ := productRepo.GetByProductNumber("P12346")
+	assert.Equal(t, 7, updatedProduct1.StockQuantity)
+	assert.Equal(t, 4, updatedProduct2.StockQuantity)
 }
 
-func TestOrderInteractor_CancelOrder_Failure_OrderNotFound(t *testing.T) {
+func TestOrderInteractor_CreateOrder_Success_WithCoupon(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	orderRepo := repository.NewOrderRepository(db)
 	memberRepo := repository.NewMemberRepository(db)
 	productRepo := repository.NewProductRepository(db)
-	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo)
+	couponRepo := repository.NewCouponRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, couponRepo, repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Pizza",
+		Category:      "food",
+		Price:         1000,
+		StockQuantity: 10,
+	})
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12346",
+		ProductName:   "Book",
+		Category:      "book",
+		Price:         2000,
+		StockQuantity: 10,
+	})
+
+	_ = couponRepo.Create(&domain.Coupon{
+		Code:          "FOOD10",
+		Name:          "식품 10% 할인",
+		DiscountType:  domain.CouponDiscountTypePercentage,
+		DiscountValue: 10,
+		StartsAt:      time.Now().Add(-time.Hour),
+		EndsAt:        time.Now().Add(time.Hour),
+		Categories:    []string{"food"},
+	})
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 3},
+			{ProductNumber: "P12346", Quantity: 1},
+		},
+		CouponCode: "FOOD10",
+	}
 
 	// When
-	err := interactor.CancelOrder(0, "M12345")
+	responseData, err := interactor.CreateOrder(req, "M12345")
 
 	// Then
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 5000, responseData.Order.TotalAmount)
+	assert.EqualValues(t, 300, responseData.Order.DiscountAmount)
+	assert.EqualValues(t, 4700, responseData.Order.PaymentAmount)
+	assert.EqualValues(t, 4700, responseData.Order.Payment.Amount)
+
+	coupon, _ := couponRepo.GetByCode("FOOD10")
+	assert.Equal(t, 1, coupon.UsedCount)
 }
 
-func TestOrderInteractor_CancelOrder_Failure_Unauthorized(t *testing.T) {
+func TestOrderInteractor_CreateOrder_Success_ParentCategoryCouponAndTax(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	orderRepo := repository.NewOrderRepository(db)
 	memberRepo := repository.NewMemberRepository(db)
 	productRepo := repository.NewProductRepository(db)
-	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo)
+	couponRepo := repository.NewCouponRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	taxClassRepo := repository.NewTaxClassRepository(db)
+	pricingEngine := usecases.NewPricingEngine(usecases.NewTaxClassCalculator(taxClassRepo), nil)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, couponRepo, repository.NewAddressRepository(db), categoryRepo, pricingEngine, gateway.NewFakePaymentGateway(), nil, db)
 
 	member := &domain.Member{
 		MemberNumber: "M12345",
@@ -155,35 +356,53 @@ func TestOrderInteractor_CancelOrder_Failure_Unauthorized(t *testing.T) {
 	}
 	member.AssignPassword("password123")
 	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
 
-	order := &domain.Order{
-		ID:            12345,
-		OrderNumber:   "O12345",
-		OrderDate:     time.Now(),
-		MemberNumber:  "M99999",
+	food := &domain.Category{Name: "식품", Slug: "food"}
+	_ = categoryRepo.Create(food)
+	_ = categoryRepo.Create(&domain.Category{ParentID: &food.ID, Name: "과자", Slug: "snacks"})
+	_ = taxClassRepo.Create(&domain.TaxClass{Name: "식품 세율", Rate: 1000, PriceMode: domain.TaxPriceModeExclusive, Categories: []string{"food"}})
+	_ = productRepo.Create(&domain.Product{
 		ProductNumber: "P12345",
+		ProductName:   "Cookie",
+		Category:      "snacks",
 		Price:         1000,
-		Quantity:      2,
-		TotalAmount:   2000,
-		IsCanceled:    false,
+		StockQuantity: 10,
+	})
+	_ = couponRepo.Create(&domain.Coupon{
+		Code:          "FOOD10",
+		Name:          "식품 10% 할인",
+		DiscountType:  domain.CouponDiscountTypePercentage,
+		DiscountValue: 10,
+		StartsAt:      time.Now().Add(-time.Hour),
+		EndsAt:        time.Now().Add(time.Hour),
+		Categories:    []string{"food"},
+	})
+
+	req := &request.CreateOrderRequest{
+		Items:      []request.CreateOrderItemRequest{{ProductNumber: "P12345", Quantity: 2}},
+		CouponCode: "FOOD10",
 	}
-	_ = orderRepo.Create(order)
 
 	// When
-	err := interactor.CancelOrder(12345, "M12345")
+	responseData, err := interactor.CreateOrder(req, "M12345")
 
 	// Then
-	assert.Error(t, err)
-	assert.Equal(t, "해당 주문에 대한 권한이 없습니다.", err.Error())
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2000, responseData.Order.TotalAmount)
+	assert.EqualValues(t, 200, responseData.Order.DiscountAmount)
+	assert.EqualValues(t, 180, responseData.Order.TaxAmount)
+	assert.EqualValues(t, 1980, responseData.Order.PaymentAmount)
 }
 
-func TestOrderInteractor_GetMyOrders_Success(t *testing.T) {
+func TestOrderInteractor_CreateOrder_Success_ShippingAddressSnapshot(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	orderRepo := repository.NewOrderRepository(db)
 	memberRepo := repository.NewMemberRepository(db)
 	productRepo := repository.NewProductRepository(db)
-	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo)
+	addressRepo := repository.NewAddressRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), addressRepo, repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
 
 	member := &domain.Member{
 		MemberNumber: "M12345",
@@ -193,91 +412,1790 @@ func TestOrderInteractor_GetMyOrders_Success(t *testing.T) {
 	}
 	member.AssignPassword("password123")
 	_ = memberRepo.Create(member)
-
-	order1 := &domain.Order{
-		OrderNumber:   "O12345",
-		OrderDate:     time.Now(),
-		MemberNumber:  "M12345",
+	_ = productRepo.Create(&domain.Product{
 		ProductNumber: "P12345",
+		ProductName:   "Test Product",
 		Price:         1000,
-		Quantity:      2,
-		TotalAmount:   2000,
-		IsCanceled:    false,
+		StockQuantity: 10,
+	})
+	address := &domain.Address{
+		MemberNumber: "M12345",
+		PostalAddress: domain.PostalAddress{
+			RecipientName: "홍길동",
+			Phone:         "010-1234-5678",
+			ZipCode:       "06236",
+			BaseAddress:   "서울특별시 강남구 테헤란로 123",
+		},
 	}
-	order2 := &domain.Order{
-		OrderNumber:   "O12346",
-		OrderDate:     time.Now(),
-		MemberNumber:  "M12345",
-		ProductNumber: "P12346",
-		Price:         1500,
-		Quantity:      1,
-		TotalAmount:   1500,
-		IsCanceled:    false,
+	_ = addressRepo.Create(address)
+
+	req := &request.CreateOrderRequest{
+		Items:     []request.CreateOrderItemRequest{{ProductNumber: "P12345", Quantity: 1}},
+		AddressID: address.ID,
 	}
-	_ = orderRepo.Create(order1)
-	_ = orderRepo.Create(order2)
 
 	// When
-	orders, err := interactor.GetMyOrders("M12345")
+	responseData, err := interactor.CreateOrder(req, "M12345")
 
 	// Then
 	assert.NoError(t, err)
-	assert.Len(t, orders, 2)
+	assert.Equal(t, "06236", responseData.Order.ShippingAddress.ZipCode)
+
+	address.PostalAddress.ZipCode = "04524"
+	_ = addressRepo.Update(address)
+	savedOrder, _ := orderRepo.GetById(responseData.Order.ID)
+	assert.Equal(t, "06236", savedOrder.ShippingAddress.ZipCode)
 }
 
-func TestOrderInteractor_GetMonthlyStats_Success(t *testing.T) {
+func TestOrderInteractor_CreateOrder_Failure_OtherMemberAddress(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	orderRepo := repository.NewOrderRepository(db)
 	memberRepo := repository.NewMemberRepository(db)
 	productRepo := repository.NewProductRepository(db)
-	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo)
+	addressRepo := repository.NewAddressRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), addressRepo, repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
 
-	order1 := &domain.Order{
-		OrderNumber:   "O12345",
-		OrderDate:     time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC),
-		MemberNumber:  "M12345",
+	address := &domain.Address{
+		MemberNumber: "M99999",
+		PostalAddress: domain.PostalAddress{
+			RecipientName: "홍길동",
+			Phone:         "010-1234-5678",
+			ZipCode:       "06236",
+			BaseAddress:   "서울특별시 강남구 테헤란로 123",
+		},
+	}
+	_ = addressRepo.Create(address)
+
+	req := &request.CreateOrderRequest{
+		Items:     []request.CreateOrderItemRequest{{ProductNumber: "P12345", Quantity: 1}},
+		AddressID: address.ID,
+	}
+
+	// When
+	responseData, err := interactor.CreateOrder(req, "M12345")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, responseData)
+	assert.Equal(t, "유효하지 않은 배송지입니다.", err.Error())
+}
+
+func TestOrderInteractor_CreateOrder_Failure_NoShippingAddress(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	_ = productRepo.Create(&domain.Product{
 		ProductNumber: "P12345",
+		ProductName:   "Test Product",
 		Price:         1000,
-		Quantity:      2,
-		TotalAmount:   2000,
-		IsCanceled:    false,
+		StockQuantity: 10,
+	})
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{{ProductNumber: "P12345", Quantity: 1}},
 	}
-	order2 := &domain.Order{
-		OrderNumber:   "O12346",
-		OrderDate:     time.Date(2024, 9, 15, 0, 0, 0, 0, time.UTC),
-		MemberNumber:  "M12346",
-		ProductNumber: "P12346",
-		Price:         1500,
-		Quantity:      1,
-		TotalAmount:   1500,
-		IsCanceled:    true,
+
+	// When
+	responseData, err := interactor.CreateOrder(req, "M12345")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, responseData)
+	assert.Equal(t, "배송지를 지정하거나 기본 배송지를 등록해 주세요.", err.Error())
+
+	orders, _ := orderRepo.GetByMemberNumber("M12345")
+	assert.Empty(t, orders)
+	unchangedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 10, unchangedProduct.StockQuantity)
+}
+
+func TestOrderInteractor_CreateOrder_Success_ShippingFee(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	rateTable := &domain.ShippingRateTable{
+		FreeShippingThreshold: 50000,
+		DefaultZone:           "mainland",
+		Zones:                 []domain.ShippingZone{{Name: "mainland", Rates: []domain.ShippingWeightRate{{MaxWeight: 5000, Fee: 3000}}}},
+	}
+	pricingEngine := usecases.NewPricingEngine(nil, usecases.NewRateTableShippingCalculator(rateTable))
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), pricingEngine, gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+		Weight:        500,
+	})
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{{ProductNumber: "P12345", Quantity: 2}},
 	}
-	_ = orderRepo.Create(order1)
-	_ = orderRepo.Create(order2)
 
 	// When
-	totalSales, totalCanceled, err := interactor.GetMonthlyStats("2024-09")
+	responseData, err := interactor.CreateOrder(req, "M12345")
 
 	// Then
 	assert.NoError(t, err)
-	assert.EqualValues(t, 2000, totalSales)
-	assert.EqualValues(t, 1500, totalCanceled)
+	assert.EqualValues(t, 2000, responseData.Order.TotalAmount)
+	assert.EqualValues(t, 3000, responseData.Order.ShippingFee)
+	assert.EqualValues(t, 5000, responseData.Order.PaymentAmount)
+
+	savedOrder, _ := orderRepo.GetById(responseData.Order.ID)
+	assert.EqualValues(t, 3000, savedOrder.ShippingFee)
+	assert.EqualValues(t, 2000, savedOrder.TotalAmount)
 }
 
-func TestOrderInteractor_GetMonthlyStats_Failure_InvalidMonth(t *testing.T) {
+func TestOrderInteractor_QuoteOrder_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	orderRepo := repository.NewOrderRepository(db)
 	memberRepo := repository.NewMemberRepository(db)
 	productRepo := repository.NewProductRepository(db)
-	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo)
+	couponRepo := repository.NewCouponRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, couponRepo, repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Pizza",
+		Category:      "food",
+		Price:         1000,
+		StockQuantity: 10,
+	})
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12346",
+		ProductName:   "Book",
+		Category:      "book",
+		Price:         2000,
+		StockQuantity: 10,
+	})
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 2},
+			{ProductNumber: "P12346", Quantity: 1},
+		},
+	}
 
 	// When
-	totalSales, totalCanceled, err := interactor.GetMonthlyStats("invalid-month")
+	responseData, err := interactor.QuoteOrder(req, "M12345")
 
 	// Then
-	assert.Error(t, err)
-	assert.EqualValues(t, 0, totalSales)
-	assert.EqualValues(t, 0, totalCanceled)
+	assert.NoError(t, err)
+	assert.Len(t, responseData.Items, 2)
+	assert.EqualValues(t, 4000, responseData.BaseAmount)
+	assert.EqualValues(t, 4000, responseData.TotalAmount)
+
+	orders, _ := orderRepo.GetByMemberNumber("M12345")
+	assert.Empty(t, orders)
+
+	unchangedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 10, unchangedProduct.StockQuantity)
+}
+
+func TestOrderInteractor_CreateOrder_Failure_CouponPerMemberLimit(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	couponRepo := repository.NewCouponRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, couponRepo, repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Pizza",
+		Category:      "food",
+		Price:         1000,
+		StockQuantity: 10,
+	})
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12346",
+		ProductName:   "Book",
+		Category:      "book",
+		Price:         2000,
+		StockQuantity: 10,
+	})
+
+	_ = couponRepo.Create(&domain.Coupon{
+		Code:           "ONCE",
+		Name:           "1회 한정 할인",
+		DiscountType:   domain.CouponDiscountTypeFixed,
+		DiscountValue:  500,
+		StartsAt:       time.Now().Add(-time.Hour),
+		EndsAt:         time.Now().Add(time.Hour),
+		PerMemberLimit: 1,
+	})
+
+	req := &request.CreateOrderRequest{
+		Items:      []request.CreateOrderItemRequest{{ProductNumber: "P12345", Quantity: 1}},
+		CouponCode: "ONCE",
+	}
+	_, _ = interactor.CreateOrder(req, "M12345")
+
+	// When
+	_, err := interactor.CreateOrder(req, "M12345")
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "회원별 쿠폰 사용 한도를 초과했습니다.", err.Error())
+
+	updatedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 9, updatedProduct.StockQuantity)
+}
+
+func TestOrderInteractor_CancelOrder_Success_ReleasesCoupon(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	couponRepo := repository.NewCouponRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, couponRepo, repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Pizza",
+		Category:      "food",
+		Price:         1000,
+		StockQuantity: 10,
+	})
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12346",
+		ProductName:   "Book",
+		Category:      "book",
+		Price:         2000,
+		StockQuantity: 10,
+	})
+
+	_ = couponRepo.Create(&domain.Coupon{
+		Code:          "FIXED500",
+		Name:          "500원 할인",
+		DiscountType:  domain.CouponDiscountTypeFixed,
+		DiscountValue: 500,
+		StartsAt:      time.Now().Add(-time.Hour),
+		EndsAt:        time.Now().Add(time.Hour),
+		UsageLimit:    1,
+	})
+
+	req := &request.CreateOrderRequest{
+		Items:      []request.CreateOrderItemRequest{{ProductNumber: "P12345", Quantity: 2}},
+		CouponCode: "FIXED500",
+	}
+	created, _ := interactor.CreateOrder(req, "M12345")
+
+	// When
+	err := interactor.CancelOrder(created.Order.ID, "M12345")
+
+	// Then
+	assert.NoError(t, err)
+
+	coupon, _ := couponRepo.GetByCode("FIXED500")
+	assert.Equal(t, 0, coupon.UsedCount)
+
+	canceledOrder, _ := orderRepo.GetById(created.Order.ID)
+	assert.EqualValues(t, 1500, canceledOrder.Payment.RefundedAmount)
+
+	movements, _ := productRepo.GetStockMovements("P12345")
+	assert.Len(t, movements, 2)
+	assert.Equal(t, domain.StockMovementOrder, movements[0].Type)
+	assert.Equal(t, -2, movements[0].Delta)
+	assert.Equal(t, domain.StockMovementCancel, movements[1].Type)
+	assert.Equal(t, 10, movements[1].Balance)
+	assert.Equal(t, created.Order.OrderNumber, movements[1].Reference)
+}
+
+func TestOrderInteractor_PartialCancelOrder_Success_ProratesDiscount(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	couponRepo := repository.NewCouponRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, couponRepo, repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Pizza",
+		Category:      "food",
+		Price:         1000,
+		StockQuantity: 10,
+	})
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12346",
+		ProductName:   "Book",
+		Category:      "book",
+		Price:         2000,
+		StockQuantity: 10,
+	})
+
+	_ = couponRepo.Create(&domain.Coupon{
+		Code:          "FIXED400",
+		Name:          "400원 할인",
+		DiscountType:  domain.CouponDiscountTypeFixed,
+		DiscountValue: 400,
+		StartsAt:      time.Now().Add(-time.Hour),
+		EndsAt:        time.Now().Add(time.Hour),
+	})
+
+	req := &request.CreateOrderRequest{
+		Items:      []request.CreateOrderItemRequest{{ProductNumber: "P12345", Quantity: 4}},
+		CouponCode: "FIXED400",
+	}
+	created, _ := interactor.CreateOrder(req, "M12345")
+
+	// When
+	_, err := interactor.PartialCancelOrder(created.Order.ID, "M12345", &request.PartialCancelOrderRequest{
+		ProductNumber: "P12345",
+		Quantity:      1,
+	})
+
+	// Then
+	assert.NoError(t, err)
+
+	updatedOrder, _ := orderRepo.GetById(created.Order.ID)
+	assert.EqualValues(t, 900, updatedOrder.Payment.RefundedAmount)
+}
+
+func TestOrderInteractor_PartialCancelOrder_Success_LastItemReleasesCoupon(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	couponRepo := repository.NewCouponRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, couponRepo, repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Pizza",
+		Category:      "food",
+		Price:         1000,
+		StockQuantity: 10,
+	})
+
+	_ = couponRepo.Create(&domain.Coupon{
+		Code:          "FIXED500",
+		Name:          "500원 할인",
+		DiscountType:  domain.CouponDiscountTypeFixed,
+		DiscountValue: 500,
+		StartsAt:      time.Now().Add(-time.Hour),
+		EndsAt:        time.Now().Add(time.Hour),
+		UsageLimit:    1,
+	})
+
+	req := &request.CreateOrderRequest{
+		Items:      []request.CreateOrderItemRequest{{ProductNumber: "P12345", Quantity: 2}},
+		CouponCode: "FIXED500",
+	}
+	created, _ := interactor.CreateOrder(req, "M12345")
+
+	// When
+	responseData, err := interactor.PartialCancelOrder(created.Order.ID, "M12345", &request.PartialCancelOrderRequest{
+		ProductNumber: "P12345",
+		Quantity:      2,
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, string(domain.OrderStatusCanceled), responseData.Status)
+
+	coupon, _ := couponRepo.GetByCode("FIXED500")
+	assert.Equal(t, 0, coupon.UsedCount)
+}
+
+func TestOrderInteractor_CreateOrder_Failure_InsufficientStock(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	product1 := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Product One",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	product2 := &domain.Product{
+		ProductNumber: "P12346",
+		ProductName:   "Product Two",
+		Price:         1500,
+		StockQuantity: 1,
+	}
+	_ = productRepo.Create(product1)
+	_ = productRepo.Create(product2)
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 2},
+			{ProductNumber: "P12346", Quantity: 2},
+		},
+	}
+
+	// When
+	responseData, err := interactor.CreateOrder(req, "M12345")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, responseData)
+	assert.Equal(t, "재고 수량이 부족합니다.", err.Error())
+
+	unchangedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 10, unchangedProduct.StockQuantity)
+}
+
+func TestOrderInteractor_CreateOrder_Failure_PaymentDeclined(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	paymentGateway := gateway.NewFakePaymentGateway()
+	paymentGateway.AuthorizationLimit = 1000
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), paymentGateway, nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 2},
+		},
+	}
+
+	// When
+	responseData, err := interactor.CreateOrder(req, "M12345")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, responseData)
+	assert.Equal(t, "결제 승인이 거절되었습니다.", err.Error())
+
+	unchangedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 10, unchangedProduct.StockQuantity)
+
+	orders, _ := orderRepo.GetByMemberNumber("M12345")
+	assert.Empty(t, orders)
+}
+
+func TestOrderInteractor_CancelOrder_Failure_CancellationPolicy(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	policy := &domain.CancellationPolicy{
+		MaxHoursAfterOrder: 72,
+		CategoryMaxHours:   map[string]int{"food": 1},
+	}
+	interactor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), policy, db)
+
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Pizza",
+		Category:      "food",
+		Price:         1000,
+		StockQuantity: 10,
+	})
+	order := &domain.Order{
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now().Add(-2 * time.Hour),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 1, LineTotal: 1000}},
+		TotalAmount:  1000,
+		Status:       domain.OrderStatusPending,
+	}
+	_ = orderRepo.Create(order)
+
+	// When
+	err := interactor.CancelOrder(order.ID, "M12345")
+
+	// Then
+	var rejected *domain.CancellationRejectedError
+	assert.ErrorAs(t, err, &rejected)
+	assert.Equal(t, domain.CancellationRejectWindowExpired, rejected.Reason)
+
+	unchanged, _ := orderRepo.GetById(order.ID)
+	assert.Equal(t, domain.OrderStatusPending, unchanged.Status)
+}
+
+func TestOrderInteractor_CancelOrder_Failure_ParentCategoryCancellationPolicy(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	policy := &domain.CancellationPolicy{
+		MaxHoursAfterOrder: 72,
+		CategoryMaxHours:   map[string]int{"food": 1},
+	}
+	interactor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), categoryRepo, usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), policy, db)
+
+	food := &domain.Category{Name: "식품", Slug: "food"}
+	_ = categoryRepo.Create(food)
+	_ = categoryRepo.Create(&domain.Category{ParentID: &food.ID, Name: "과자", Slug: "snacks"})
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Cookie",
+		Category:      "snacks",
+		Price:         1000,
+		StockQuantity: 10,
+	})
+	order := &domain.Order{
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now().Add(-2 * time.Hour),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 1, LineTotal: 1000}},
+		TotalAmount:  1000,
+		Status:       domain.OrderStatusPending,
+	}
+	_ = orderRepo.Create(order)
+
+	// When
+	err := interactor.CancelOrder(order.ID, "M12345")
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "food 카테고리 상품은 주문 후 1시간까지만 취소할 수 있습니다.", err.Error())
+}
+
+func TestOrderInteractor_AdminCancelOrder_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Pizza",
+		Category:      "food",
+		Price:         1000,
+		StockQuantity: 10,
+	})
+
+	created, _ := interactor.CreateOrder(&request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{{ProductNumber: "P12345", Quantity: 2}},
+	}, "M12345")
+
+	// When
+	responseData, err := interactor.AdminCancelOrder(created.Order.OrderNumber, "ADMIN1", &request.AdminCancelOrderRequest{
+		ReasonCode: "out_of_stock",
+		Note:       "창고 재고 불일치",
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, string(domain.OrderStatusCanceled), responseData.Status)
+	assert.Equal(t, "ADMIN1", responseData.CanceledBy)
+	assert.Equal(t, "out_of_stock", responseData.CancelReason)
+	assert.EqualValues(t, 2000, responseData.Payment.RefundedAmount)
+
+	product, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 10, product.StockQuantity)
+}
+
+func TestOrderInteractor_AdminCancelOrder_Failure_InvalidReason(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewOrderInteractor(repository.NewOrderRepository(db), repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	// When
+	responseData, err := interactor.AdminCancelOrder("O12345", "ADMIN1", &request.AdminCancelOrderRequest{ReasonCode: "bored", Note: "note"})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, responseData)
+	assert.Equal(t, "유효하지 않은 취소 사유입니다.", err.Error())
+}
+
+func TestOrderInteractor_CancelOrder_Success_VoidsUncapturedPayment(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	paymentGateway := &recordingPaymentGateway{FakePaymentGateway: gateway.NewFakePaymentGateway()}
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), paymentGateway, nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	})
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 2},
+		},
+	}
+	created, _ := interactor.CreateOrder(req, "M12345")
+
+	// When
+	err := interactor.CancelOrder(created.Order.ID, "M12345")
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2000, paymentGateway.voidedAmount)
+	assert.EqualValues(t, 0, paymentGateway.refundedAmount)
+
+	canceledOrder, _ := orderRepo.GetById(created.Order.ID)
+	assert.EqualValues(t, 0, canceledOrder.Payment.PendingRefund)
+}
+
+func TestOrderInteractor_CancelOrder_Failure_GatewayErrorKeepsPendingRefund(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	paymentGateway := &recordingPaymentGateway{FakePaymentGateway: gateway.NewFakePaymentGateway()}
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), paymentGateway, nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	})
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 2},
+		},
+	}
+	created, _ := interactor.CreateOrder(req, "M12345")
+	paymentGateway.failVoid = true
+
+	// When
+	err := interactor.CancelOrder(created.Order.ID, "M12345")
+
+	// Then
+	assert.Error(t, err)
+
+	canceledOrder, _ := orderRepo.GetById(created.Order.ID)
+	assert.Equal(t, domain.OrderStatusCanceled, canceledOrder.Status)
+	assert.EqualValues(t, 2000, canceledOrder.Payment.PendingRefund)
+}
+
+func TestOrderInteractor_CancelOrder_Success_RefundsPayment(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 2},
+		},
+	}
+	created, _ := interactor.CreateOrder(req, "M12345")
+
+	// When
+	err := interactor.CancelOrder(created.Order.ID, "M12345")
+
+	// Then
+	assert.NoError(t, err)
+
+	canceledOrder, _ := orderRepo.GetById(created.Order.ID)
+	assert.Equal(t, domain.OrderStatusCanceled, canceledOrder.Status)
+	assert.Equal(t, domain.PaymentStatusRefunded, canceledOrder.Payment.Status)
+	assert.EqualValues(t, 2000, canceledOrder.Payment.RefundedAmount)
+}
+
+func TestOrderInteractor_PartialCancelOrder_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 3},
+		},
+	}
+	created, _ := interactor.CreateOrder(req, "M12345")
+
+	// When
+	responseData, err := interactor.PartialCancelOrder(created.Order.ID, "M12345", &request.PartialCancelOrderRequest{
+		ProductNumber: "P12345",
+		Quantity:      2,
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2000, responseData.CanceledAmount)
+	assert.Equal(t, string(domain.OrderStatusPending), responseData.Status)
+
+	updatedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 9, updatedProduct.StockQuantity)
+
+	updatedOrder, _ := orderRepo.GetById(created.Order.ID)
+	assert.Equal(t, 2, updatedOrder.Items[0].CanceledQuantity)
+	assert.Len(t, updatedOrder.Cancellations, 1)
+	assert.Equal(t, domain.PaymentStatusPartiallyRefunded, updatedOrder.Payment.Status)
+	assert.EqualValues(t, 2000, updatedOrder.Payment.RefundedAmount)
+}
+
+func TestOrderInteractor_CancelOrder_Success_AfterPartialCancel(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 3},
+		},
+	}
+	created, _ := interactor.CreateOrder(req, "M12345")
+	_, _ = interactor.PartialCancelOrder(created.Order.ID, "M12345", &request.PartialCancelOrderRequest{
+		ProductNumber: "P12345",
+		Quantity:      1,
+	})
+
+	// When
+	err := interactor.CancelOrder(created.Order.ID, "M12345")
+
+	// Then
+	assert.NoError(t, err)
+
+	updatedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 10, updatedProduct.StockQuantity)
+
+	canceledOrder, _ := orderRepo.GetById(created.Order.ID)
+	assert.Equal(t, domain.OrderStatusCanceled, canceledOrder.Status)
+	assert.Len(t, canceledOrder.Cancellations, 2)
+	assert.EqualValues(t, 3000, canceledOrder.Payment.RefundedAmount)
+}
+
+func TestOrderInteractor_PartialCancelOrder_Failure_Unauthorized(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPaid,
+	}
+	_ = orderRepo.Create(order)
+
+	// When
+	_, err := interactor.PartialCancelOrder(12345, "M67890", &request.PartialCancelOrderRequest{
+		ProductNumber: "P12345",
+		Quantity:      1,
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "해당 주문에 대한 권한이 없습니다.", err.Error())
+}
+
+func TestOrderInteractor_ChangeOrderStatus_Success_CapturesPaymentOnShipment(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 2},
+		},
+	}
+	created, _ := interactor.CreateOrder(req, "M12345")
+
+	// When
+	responseData, err := interactor.ChangeOrderStatus(created.Order.ID, &request.UpdateOrderStatusRequest{Status: "shipped"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "shipped", responseData.Status)
+	assert.Equal(t, "captured", responseData.Payment.Status)
+	assert.NotEmpty(t, responseData.Payment.CapturedAt)
+}
+
+func TestOrderInteractor_ChangeOrderStatus_Success_PaidCapturesPayment(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	})
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 2},
+		},
+	}
+	created, _ := interactor.CreateOrder(req, "M12345")
+
+	// When
+	responseData, err := interactor.ChangeOrderStatus(created.Order.ID, &request.UpdateOrderStatusRequest{Status: "paid"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "pending", created.Order.Status)
+	assert.Equal(t, "paid", responseData.Status)
+	assert.Equal(t, "captured", responseData.Payment.Status)
+	assert.NotEmpty(t, responseData.PaidAt)
+}
+
+func TestOrderInteractor_ChangeOrderStatus_Success_ShipAfterPartialCancel(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 3},
+		},
+	}
+	created, _ := interactor.CreateOrder(req, "M12345")
+	_, _ = interactor.PartialCancelOrder(created.Order.ID, "M12345", &request.PartialCancelOrderRequest{
+		ProductNumber: "P12345",
+		Quantity:      2,
+	})
+
+	// When
+	responseData, err := interactor.ChangeOrderStatus(created.Order.ID, &request.UpdateOrderStatusRequest{Status: "shipped"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "shipped", responseData.Status)
+	assert.Equal(t, "partially_refunded", responseData.Payment.Status)
+	assert.NotEmpty(t, responseData.Payment.CapturedAt)
+
+	updatedOrder, _ := orderRepo.GetById(created.Order.ID)
+	assert.EqualValues(t, 1000, updatedOrder.Payment.RefundableAmount())
+}
+
+func TestOrderInteractor_CreateOrder_Concurrent_NoOverselling(t *testing.T) {
+	// Given
+	db := fixtures.SetupConcurrentTestDB(t)
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+	fixtures.CreateDefaultAddress(db, "M12345")
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 5,
+	}
+	_ = productRepo.Create(product)
+
+	buyers := 20
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+
+	// When
+	for i := 0; i < buyers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := &request.CreateOrderRequest{
+				Items: []request.CreateOrderItemRequest{
+					{ProductNumber: "P12345", Quantity: 1},
+				},
+			}
+			if _, err := interactor.CreateOrder(req, "M12345"); err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Then
+	assert.Equal(t, 5, succeeded)
+
+	updatedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 0, updatedProduct.StockQuantity)
+
+	orders, _ := orderRepo.GetByMemberNumber("M12345")
+	assert.Len(t, orders, 5)
+}
+
+func TestOrderInteractor_CreateOrder_Concurrent_CouponUsageLimit(t *testing.T) {
+	// Given
+	db := fixtures.SetupConcurrentTestDB(t)
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	couponRepo := repository.NewCouponRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, couponRepo, repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	buyers := 10
+	for i := 0; i < buyers; i++ {
+		member := &domain.Member{
+			MemberNumber: fmt.Sprintf("M%05d", i),
+			AccountId:    fmt.Sprintf("testuser%d", i),
+			NickName:     "Test User",
+			Email:        fmt.Sprintf("testuser%d@example.com", i),
+		}
+		member.AssignPassword("password123")
+		_ = memberRepo.Create(member)
+		fixtures.CreateDefaultAddress(db, member.MemberNumber)
+	}
+
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Pizza",
+		Price:         1000,
+		StockQuantity: 100,
+	})
+	_ = couponRepo.Create(&domain.Coupon{
+		Code:          "FIRST3",
+		Name:          "선착순 할인",
+		DiscountType:  domain.CouponDiscountTypeFixed,
+		DiscountValue: 500,
+		StartsAt:      time.Now().Add(-time.Hour),
+		EndsAt:        time.Now().Add(time.Hour),
+		UsageLimit:    3,
+	})
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+
+	// When
+	for i := 0; i < buyers; i++ {
+		wg.Add(1)
+		go func(memberNumber string) {
+			defer wg.Done()
+			req := &request.CreateOrderRequest{
+				Items:      []request.CreateOrderItemRequest{{ProductNumber: "P12345", Quantity: 1}},
+				CouponCode: "FIRST3",
+			}
+			if _, err := interactor.CreateOrder(req, memberNumber); err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}(fmt.Sprintf("M%05d", i))
+	}
+	wg.Wait()
+
+	// Then
+	assert.Equal(t, 3, succeeded)
+
+	coupon, _ := couponRepo.GetByCode("FIRST3")
+	assert.Equal(t, 3, coupon.UsedCount)
+
+	updatedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 97, updatedProduct.StockQuantity)
+}
+
+func TestOrderInteractor_CancelOrder_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPending,
+	}
+	_ = orderRepo.Create(order)
+
+	// When
+	err := interactor.CancelOrder(12345, "M12345")
+
+	// Then
+	assert.NoError(t, err)
+
+	updatedOrder, _ := orderRepo.GetByOrderNumber("O12345")
+	assert.True(t, updatedOrder.IsCanceled())
+
+	updatedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 12, updatedProduct.StockQuantity)
+}
+
+func TestOrderInteractor_CancelOrder_Concurrent_RestoresStockOnce(t *testing.T) {
+	// Given
+	db := fixtures.SetupConcurrentTestDB(t)
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+	}
+	_ = orderRepo.Create(order)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+
+	// When
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := interactor.CancelOrder(12345, "M12345"); err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Then
+	assert.Equal(t, 1, succeeded)
+
+	updatedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 12, updatedProduct.StockQuantity)
+}
+
+func TestOrderInteractor_CancelOrder_Failure_OrderNotFound(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	// When
+	err := interactor.CancelOrder(0, "M12345")
+
+	// Then
+	assert.Error(t, err)
+}
+
+func TestOrderInteractor_CancelOrder_Failure_Unauthorized(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M99999",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPending,
+	}
+	_ = orderRepo.Create(order)
+
+	// When
+	err := interactor.CancelOrder(12345, "M12345")
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "해당 주문에 대한 권한이 없습니다.", err.Error())
+}
+
+func TestOrderInteractor_CancelOrder_Failure_AlreadyShipped(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusShipped,
+	}
+	_ = orderRepo.Create(order)
+
+	// When
+	err := interactor.CancelOrder(12345, "M12345")
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "배송이 시작된 주문은 취소할 수 없습니다.", err.Error())
+
+	unchangedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 10, unchangedProduct.StockQuantity)
+}
+
+func TestOrderInteractor_ChangeOrderStatus_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPaid,
+	}
+	_ = orderRepo.Create(order)
+
+	// When
+	responseData, err := interactor.ChangeOrderStatus(12345, &request.UpdateOrderStatusRequest{Status: "shipped"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "shipped", responseData.Status)
+	assert.NotEmpty(t, responseData.ShippedAt)
+
+	updatedOrder, _ := orderRepo.GetById(12345)
+	assert.Equal(t, domain.OrderStatusShipped, updatedOrder.Status)
+	assert.NotNil(t, updatedOrder.ShippedAt)
+}
+
+func TestOrderInteractor_ChangeOrderStatus_Failure_InvalidTransition(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPending,
+	}
+	_ = orderRepo.Create(order)
+
+	// When
+	responseData, err := interactor.ChangeOrderStatus(12345, &request.UpdateOrderStatusRequest{Status: "delivered"})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, responseData)
+	assert.Equal(t, "배송 중인 주문만 배송 완료 처리할 수 있습니다.", err.Error())
+}
+
+func TestOrderInteractor_GetMyOrders_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+
+	order1 := &domain.Order{
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPending,
+	}
+	order2 := &domain.Order{
+		OrderNumber:  "O12346",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12346", Price: 1500, Quantity: 1, LineTotal: 1500}},
+		TotalAmount:  1500,
+		Status:       domain.OrderStatusPending,
+	}
+	_ = orderRepo.Create(order1)
+	_ = orderRepo.Create(order2)
+
+	// When
+	orders, err := interactor.GetMyOrders(&domain.OrderQuery{MemberNumber: "M12345"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, orders.Orders, 2)
+	assert.False(t, orders.HasNext)
+	assert.Equal(t, order2.ID, orders.Orders[0].ID)
+}
+
+func TestOrderInteractor_GetMyOrders_Success_CursorPagination(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	for i := 0; i < 3; i++ {
+		_ = orderRepo.Create(&domain.Order{
+			OrderNumber:  fmt.Sprintf("O1234%d", i),
+			OrderDate:    time.Now(),
+			MemberNumber: "M12345",
+			Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 1, LineTotal: 1000}},
+			TotalAmount:  1000,
+			Status:       domain.OrderStatusPending,
+		})
+	}
+
+	// When
+	firstPage, err := interactor.GetMyOrders(&domain.OrderQuery{MemberNumber: "M12345", Limit: 2})
+	assert.NoError(t, err)
+	secondPage, err := interactor.GetMyOrders(&domain.OrderQuery{MemberNumber: "M12345", Limit: 2, Cursor: firstPage.NextCursor})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, firstPage.Orders, 2)
+	assert.True(t, firstPage.HasNext)
+	assert.Equal(t, "O12342", firstPage.Orders[0].OrderNumber)
+	assert.Len(t, secondPage.Orders, 1)
+	assert.False(t, secondPage.HasNext)
+	assert.Equal(t, "O12340", secondPage.Orders[0].OrderNumber)
+}
+
+func TestOrderInteractor_GetOrder_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	order := &domain.Order{
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPending,
+	}
+	_ = orderRepo.Create(order)
+
+	// When
+	responseData, err := interactor.GetOrder(order.ID, "M12345", false)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "O12345", responseData.OrderNumber)
+	assert.Len(t, responseData.Items, 1)
+}
+
+func TestOrderInteractor_GetOrder_Failure_Unauthorized(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	order := &domain.Order{
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPending,
+	}
+	_ = orderRepo.Create(order)
+
+	// When
+	responseData, err := interactor.GetOrder(order.ID, "M99999", false)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, responseData)
+	assert.Equal(t, "해당 주문에 대한 권한이 없습니다.", err.Error())
+}
+
+func TestOrderInteractor_SearchOrders_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	for i := 0; i < 3; i++ {
+		_ = orderRepo.Create(&domain.Order{
+			OrderNumber:  fmt.Sprintf("O1234%d", i),
+			OrderDate:    time.Now(),
+			MemberNumber: "M12345",
+			Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 1, LineTotal: 1000}},
+			TotalAmount:  1000,
+			Status:       domain.OrderStatusPending,
+		})
+	}
+
+	// When
+	responseData, err := interactor.SearchOrders(&domain.OrderSearchCriteria{MemberNumber: "M12345", Size: 2})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, responseData.Orders, 2)
+	assert.Equal(t, 1, responseData.Page)
+	assert.EqualValues(t, 3, responseData.TotalCount)
+	assert.Equal(t, 2, responseData.TotalPages)
+}
+
+func TestOrderInteractor_GetOrderByNumber_Failure_NotFound(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewOrderInteractor(repository.NewOrderRepository(db), repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	// When
+	responseData, err := interactor.GetOrderByNumber("nonexistent")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, responseData)
+	assert.Equal(t, "주문을 찾을 수 없습니다.", err.Error())
+}
+
+func TestOrderInteractor_GetMonthlyStats_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	order1 := &domain.Order{
+		OrderNumber:  "O12345",
+		OrderDate:    time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPending,
+	}
+	order2 := &domain.Order{
+		OrderNumber:  "O12346",
+		OrderDate:    time.Date(2024, 9, 15, 0, 0, 0, 0, time.UTC),
+		MemberNumber: "M12346",
+		Items:        []domain.OrderItem{{ProductNumber: "P12346", Price: 1500, Quantity: 1, LineTotal: 1500}},
+		TotalAmount:  1500,
+		Status:       domain.OrderStatusCanceled,
+	}
+	_ = orderRepo.Create(order1)
+	_ = orderRepo.Create(order2)
+
+	// When
+	stats, err := interactor.GetMonthlyStats("2024-09")
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2000, stats.TotalSales)
+	assert.EqualValues(t, 1500, stats.TotalCanceled)
+}
+
+func TestOrderInteractor_GetMonthlyStats_Success_CancelReasons(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, repository.NewMemberRepository(db), repository.NewProductRepository(db), repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	reasons := []domain.CancelReason{domain.CancelReasonFraud, domain.CancelReasonFraud, domain.CancelReasonCustomerRequest}
+	for i, reason := range reasons {
+		_ = orderRepo.Create(&domain.Order{
+			OrderNumber:  fmt.Sprintf("O1234%d", i),
+			OrderDate:    time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC),
+			MemberNumber: "M12345",
+			Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 1, LineTotal: 1000}},
+			TotalAmount:  1000,
+			Status:       domain.OrderStatusCanceled,
+			CancelReason: reason,
+		})
+	}
+
+	// When
+	stats, err := interactor.GetMonthlyStats("2024-09")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, stats.CancelReasons, 2)
+	assert.Equal(t, domain.CancelReasonCustomerRequest, stats.CancelReasons[0].Reason)
+	assert.EqualValues(t, 1, stats.CancelReasons[0].Count)
+	assert.Equal(t, domain.CancelReasonFraud, stats.CancelReasons[1].Reason)
+	assert.EqualValues(t, 2, stats.CancelReasons[1].Count)
+	assert.EqualValues(t, 2000, stats.CancelReasons[1].Amount)
+}
+
+func TestOrderInteractor_GetMonthlyStats_Failure_InvalidMonth(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), repository.NewAddressRepository(db), repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	// When
+	stats, err := interactor.GetMonthlyStats("invalid-month")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, stats)
+}
+
+func TestOrderInteractor_CreateOrder_Failure_DefaultAddressLookupError(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	addressRepo := &failingDefaultAddressRepository{AddressRepository: repository.NewAddressRepository(db)}
+	interactor := usecases.NewOrderInteractor(orderRepo, memberRepo, productRepo, repository.NewCouponRepository(db), addressRepo, repository.NewCategoryRepository(db), usecases.NewPricingEngine(nil, nil), gateway.NewFakePaymentGateway(), nil, db)
+
+	member := &domain.Member{
+		MemberNumber: "M12345",
+		AccountId:    "testuser",
+		NickName:     "Test User",
+		Email:        "testuser@example.com",
+	}
+	member.AssignPassword("password123")
+	_ = memberRepo.Create(member)
+
+	_ = productRepo.Create(&domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	})
+
+	req := &request.CreateOrderRequest{
+		Items: []request.CreateOrderItemRequest{
+			{ProductNumber: "P12345", Quantity: 1},
+		},
+	}
+
+	// When
+	responseData, err := interactor.CreateOrder(req, "M12345")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, responseData)
+	assert.Equal(t, "배송지를 조회할 수 없습니다.", err.Error())
 }
@@ -0,0 +1,149 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+)
+
+type PricingEngine interface {
+	Calculate(input *PricingInput) (*domain.PriceBreakdown, error)
+}
+
+type PricingInput struct {
+	Member          *domain.Member
+	ShippingAddress *domain.PostalAddress
+	Items           []PricingItem
+	Coupon          *domain.Coupon
+}
+
+type PricingItem struct {
+	Product  *domain.Product
+	Quantity int
+	// 상품 카테고리의 상위 카테고리 슬러그 (가까운 순서). 상위 카테고리에 지정된 과세 분류와 쿠폰도 적용
+	AncestorCategories []string
+}
+
+// Categories 는 상품 카테고리와 상위 카테고리 슬러그를 가까운 순서로 반환합니다.
+func (i PricingItem) Categories() []string {
+	return append([]string{i.Product.Category}, i.AncestorCategories...)
+}
+
+// 할인 후 상품별 금액으로 세금을 계산해 breakdown 의 각 상품에 기록
+// TaxInclusive가 true이면 세금이 이미 가격에 포함되어 있어 결제 금액에 더하지 않음
+type TaxCalculator interface {
+	CalculateTax(input *PricingInput, breakdown *domain.PriceBreakdown) error
+}
+
+type ShippingCalculator interface {
+	CalculateShipping(input *PricingInput, merchandiseAmount int64) (int64, error)
+}
+
+type pricingEngineImpl struct {
+	taxCalculator      TaxCalculator
+	shippingCalculator ShippingCalculator
+}
+
+// 계산기를 지정하지 않으면 세금과 배송비를 부과하지 않음
+func NewPricingEngine(tc TaxCalculator, sc ShippingCalculator) PricingEngine {
+	if tc == nil {
+		tc = noTaxCalculator{}
+	}
+	if sc == nil {
+		sc = freeShippingCalculator{}
+	}
+	return &pricingEngineImpl{
+		taxCalculator:      tc,
+		shippingCalculator: sc,
+	}
+}
+
+func (pe *pricingEngineImpl) Calculate(input *PricingInput) (*domain.PriceBreakdown, error) {
+	breakdown := &domain.PriceBreakdown{}
+	for _, item := range input.Items {
+		if item.Product.Price <= 0 {
+			return nil, errors.New("가격이 잘못되었습니다.")
+		}
+		if item.Quantity <= 0 {
+			return nil, errors.New("수량이 잘못되었습니다.")
+		}
+		breakdown.Items = append(breakdown.Items, domain.PriceBreakdownItem{
+			ProductNumber: item.Product.ProductNumber,
+			ProductName:   item.Product.ProductName,
+			SKU:           item.Product.SKU,
+			OptionValues:  item.Product.OptionValues,
+			Price:         item.Product.Price,
+			Quantity:      item.Quantity,
+			BaseAmount:    item.Product.Price * int64(item.Quantity),
+		})
+	}
+	breakdown.Summarize()
+
+	if input.Coupon != nil {
+		if err := pe.applyCoupon(breakdown, input); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := pe.taxCalculator.CalculateTax(input, breakdown); err != nil {
+		return nil, err
+	}
+	breakdown.Summarize()
+
+	shippingFee, err := pe.shippingCalculator.CalculateShipping(input, breakdown.BaseAmount-breakdown.DiscountAmount)
+	if err != nil {
+		return nil, err
+	}
+	breakdown.ShippingFee = shippingFee
+	breakdown.Summarize()
+
+	return breakdown, nil
+}
+
+func (pe *pricingEngineImpl) applyCoupon(breakdown *domain.PriceBreakdown, input *PricingInput) error {
+	coupon := input.Coupon
+	if err := coupon.CheckAvailable(time.Now()); err != nil {
+		return err
+	}
+
+	var eligible []int
+	var eligibleAmount int64
+	for i, item := range input.Items {
+		if coupon.AppliesTo(item.Product, item.AncestorCategories...) {
+			eligible = append(eligible, i)
+			eligibleAmount += breakdown.Items[i].BaseAmount
+		}
+	}
+
+	discount, err := coupon.CalculateDiscount(breakdown.BaseAmount, eligibleAmount)
+	if err != nil {
+		return err
+	}
+
+	// 할인 금액을 적용 대상 상품 금액 비율로 나누고 나머지는 마지막 상품에 배분
+	remaining := discount
+	for n, i := range eligible {
+		line := &breakdown.Items[i]
+		share := discount * line.BaseAmount / eligibleAmount
+		if n == len(eligible)-1 {
+			share = remaining
+		}
+		line.DiscountAmount = share
+		remaining -= share
+	}
+	breakdown.CouponCode = coupon.Code
+	return nil
+}
+
+type noTaxCalculator struct{}
+
+func (noTaxCalculator) CalculateTax(input *PricingInput, breakdown *domain.PriceBreakdown) error {
+	return nil
+}
+
+type freeShippingCalculator struct{}
+
+func (freeShippingCalculator) CalculateShipping(input *PricingInput, merchandiseAmount int64) (int64, error) {
+	return 0, nil
+}
@@ -0,0 +1,133 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/stretchr/testify/assert"
+)
+
+type fixedRateTaxCalculator struct {
+	rate int64
+}
+
+func (c fixedRateTaxCalculator) CalculateTax(input *usecases.PricingInput, breakdown *domain.PriceBreakdown) error {
+	for i := range breakdown.Items {
+		line := &breakdown.Items[i]
+		line.TaxAmount = (line.BaseAmount - line.DiscountAmount) * c.rate / 100
+	}
+	return nil
+}
+
+type flatShippingCalculator struct {
+	fee int64
+}
+
+func (c flatShippingCalculator) CalculateShipping(input *usecases.PricingInput, merchandiseAmount int64) (int64, error) {
+	return c.fee, nil
+}
+
+func TestPricingEngine_Calculate_Success_Default(t *testing.T) {
+	// Given
+	engine := usecases.NewPricingEngine(nil, nil)
+	input := &usecases.PricingInput{
+		Member: &domain.Member{MemberNumber: "M12345"},
+		Items: []usecases.PricingItem{
+			{Product: &domain.Product{ProductNumber: "P12345", ProductName: "Pizza", Price: 1000}, Quantity: 2},
+			{Product: &domain.Product{ProductNumber: "P12346", ProductName: "Book", Price: 1500}, Quantity: 1},
+		},
+	}
+
+	// When
+	breakdown, err := engine.Calculate(input)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, breakdown.Items, 2)
+	assert.EqualValues(t, 3500, breakdown.BaseAmount)
+	assert.EqualValues(t, 0, breakdown.TaxAmount)
+	assert.EqualValues(t, 0, breakdown.ShippingFee)
+	assert.EqualValues(t, 3500, breakdown.TotalAmount)
+}
+
+func TestPricingEngine_Calculate_Success_CouponTaxAndShipping(t *testing.T) {
+	// Given
+	engine := usecases.NewPricingEngine(fixedRateTaxCalculator{rate: 10}, flatShippingCalculator{fee: 3000})
+	input := &usecases.PricingInput{
+		Member: &domain.Member{MemberNumber: "M12345"},
+		Items: []usecases.PricingItem{
+			{Product: &domain.Product{ProductNumber: "P12345", Category: "food", Price: 1000}, Quantity: 3},
+			{Product: &domain.Product{ProductNumber: "P12346", Category: "book", Price: 2000}, Quantity: 1},
+		},
+		Coupon: &domain.Coupon{
+			Code:          "FOOD10",
+			DiscountType:  domain.CouponDiscountTypePercentage,
+			DiscountValue: 10,
+			StartsAt:      time.Now().Add(-time.Hour),
+			EndsAt:        time.Now().Add(time.Hour),
+			Categories:    []string{"food"},
+		},
+	}
+
+	// When
+	breakdown, err := engine.Calculate(input)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "FOOD10", breakdown.CouponCode)
+	assert.EqualValues(t, 300, breakdown.Items[0].DiscountAmount)
+	assert.EqualValues(t, 0, breakdown.Items[1].DiscountAmount)
+	assert.EqualValues(t, 270, breakdown.Items[0].TaxAmount)
+	assert.EqualValues(t, 200, breakdown.Items[1].TaxAmount)
+	assert.EqualValues(t, 5000, breakdown.BaseAmount)
+	assert.EqualValues(t, 300, breakdown.DiscountAmount)
+	assert.EqualValues(t, 470, breakdown.TaxAmount)
+	assert.EqualValues(t, 3000, breakdown.ShippingFee)
+	assert.EqualValues(t, 8170, breakdown.TotalAmount)
+}
+
+func TestPricingEngine_Calculate_Success_DiscountSplitAcrossItems(t *testing.T) {
+	// Given
+	engine := usecases.NewPricingEngine(nil, nil)
+	input := &usecases.PricingInput{
+		Items: []usecases.PricingItem{
+			{Product: &domain.Product{ProductNumber: "P12345", Price: 1000}, Quantity: 1},
+			{Product: &domain.Product{ProductNumber: "P12346", Price: 2000}, Quantity: 1},
+		},
+		Coupon: &domain.Coupon{
+			Code:          "FIXED1000",
+			DiscountType:  domain.CouponDiscountTypeFixed,
+			DiscountValue: 1000,
+			StartsAt:      time.Now().Add(-time.Hour),
+			EndsAt:        time.Now().Add(time.Hour),
+		},
+	}
+
+	// When
+	breakdown, err := engine.Calculate(input)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 333, breakdown.Items[0].DiscountAmount)
+	assert.EqualValues(t, 667, breakdown.Items[1].DiscountAmount)
+	assert.EqualValues(t, 2000, breakdown.TotalAmount)
+}
+
+func TestPricingEngine_Calculate_Failure_InvalidPrice(t *testing.T) {
+	// Given
+	engine := usecases.NewPricingEngine(nil, nil)
+	input := &usecases.PricingInput{
+		Items: []usecases.PricingItem{
+			{Product: &domain.Product{ProductNumber: "P12345", Price: 0}, Quantity: 1},
+		},
+	}
+
+	// When
+	_, err := engine.Calculate(input)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "가격이 잘못되었습니다.", err.Error())
+}
@@ -2,7 +2,10 @@ package usecases
 
 import (
 	"errors"
+	"strings"
+	"time"
 
+	"github.com/HongJungWan/commerce-system/internal/domain"
 	"github.com/HongJungWan/commerce-system/internal/domain/repository"
 	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
 	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/response"
@@ -10,26 +13,47 @@ import (
 )
 
 type ProductInteractor struct {
-	ProductRepository repository.ProductRepository
-	DB                *gorm.DB
+	ProductRepository  repository.ProductRepository
+	CategoryRepository repository.CategoryRepository
+	DB                 *gorm.DB
 }
 
-func NewProductInteractor(repo repository.ProductRepository, db *gorm.DB) *ProductInteractor {
+func NewProductInteractor(repo repository.ProductRepository, cr repository.CategoryRepository, db *gorm.DB) *ProductInteractor {
 	return &ProductInteractor{
-		ProductRepository: repo,
-		DB:                db,
+		ProductRepository:  repo,
+		CategoryRepository: cr,
+		DB:                 db,
 	}
 }
 
-func (pi *ProductInteractor) CreateProduct(req *request.CreateProductRequest) (*response.CreateProductResponse, error) {
+func (pi *ProductInteractor) CreateProduct(req *request.CreateProductRequest, adminNumber string) (*response.CreateProductResponse, error) {
 	product, err := req.CreateToEntity()
 	if err != nil {
 		return nil, err
 	}
+	if req.CategoryID != nil {
+		if err := pi.assignCategory(pi.CategoryRepository, product, *req.CategoryID); err != nil {
+			return nil, err
+		}
+	}
 
-	if err := pi.ProductRepository.Create(product); err != nil {
+	// 초기 재고는 입고 이력으로 기록
+	initialStock := product.StockQuantity
+	product.StockQuantity = 0
+	err = pi.DB.Transaction(func(tx *gorm.DB) error {
+		productRepo := pi.ProductRepository.WithTx(tx)
+		if err := productRepo.Create(product); err != nil {
+			return err
+		}
+		if initialStock > 0 {
+			return moveStock(productRepo, product.ProductNumber, domain.StockMovementReceipt, initialStock, adminNumber, "")
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	product.StockQuantity = initialStock
 
 	productResponse := response.NewProductResponse(product)
 
@@ -39,29 +63,269 @@ func (pi *ProductInteractor) CreateProduct(req *request.CreateProductRequest) (*
 	}, nil
 }
 
-func (pi *ProductInteractor) GetProducts(filter map[string]interface{}) ([]response.ProductResponse, error) {
-	products, err := pi.ProductRepository.GetAll(filter)
+func (pi *ProductInteractor) GetProducts(query *domain.ProductQuery) (*response.ProductListResponse, error) {
+	if len(query.Categories) > 0 {
+		slugs, err := pi.categorySlugsWithDescendants(query.Categories...)
+		if err != nil {
+			return nil, err
+		}
+		query.Categories = slugs
+	}
+
+	products, next, err := pi.ProductRepository.Search(query)
+	if err != nil {
+		return nil, err
+	}
+	totalCount, err := pi.ProductRepository.Count(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentNumbers []string
+	for _, product := range products {
+		if product.HasVariants() {
+			parentNumbers = append(parentNumbers, product.ProductNumber)
+		}
+	}
+	variants, err := pi.ProductRepository.GetVariants(parentNumbers...)
 	if err != nil {
 		return nil, err
 	}
+	variantsByParent := make(map[string][]*domain.Product)
+	for _, variant := range variants {
+		variantsByParent[variant.ParentProductNumber] = append(variantsByParent[variant.ParentProductNumber], variant)
+	}
 
-	var productResponses []response.ProductResponse
+	productResponses := make([]response.ProductResponse, 0, len(products))
 	for _, product := range products {
-		productResponses = append(productResponses, *response.NewProductResponse(product))
+		productResponses = append(productResponses, *response.NewProductGroupResponse(product, variantsByParent[product.ProductNumber]))
 	}
 
-	return productResponses, nil
+	return response.NewProductListResponse(productResponses, totalCount, next), nil
 }
 
-func (pi *ProductInteractor) UpdateStock(id int, quantity int) error {
+func (pi *ProductInteractor) AddVariant(parentId int, req *request.AddVariantRequest, adminNumber string) (*response.ProductResponse, error) {
+	var variant *domain.Product
+	err := pi.DB.Transaction(func(tx *gorm.DB) error {
+		productRepo := pi.ProductRepository.WithTx(tx)
+
+		parent, err := productRepo.GetById(parentId)
+		if err != nil {
+			return err
+		}
+		variant, err = req.ToEntity(parent)
+		if err != nil {
+			return err
+		}
+
+		if _, err := productRepo.GetBySKU(variant.SKU); err == nil {
+			return errors.New("이미 사용 중인 SKU입니다.")
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		siblings, err := productRepo.GetVariants(parent.ProductNumber)
+		if err != nil {
+			return err
+		}
+		for _, sibling := range siblings {
+			if sibling.HasSameOptions(variant.OptionValues) {
+				return errors.New("같은 옵션 조합의 옵션 상품이 이미 있습니다.")
+			}
+		}
+
+		// 초기 재고는 입고 이력으로 기록
+		initialStock := variant.StockQuantity
+		variant.StockQuantity = 0
+		if err := productRepo.Create(variant); err != nil {
+			return err
+		}
+		if initialStock > 0 {
+			if err := moveStock(productRepo, variant.ProductNumber, domain.StockMovementReceipt, initialStock, adminNumber, ""); err != nil {
+				return err
+			}
+		}
+		variant.StockQuantity = initialStock
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.NewProductResponse(variant), nil
+}
+
+func (pi *ProductInteractor) UpdateProduct(id int, req *request.UpdateProductRequest, adminNumber string) (*response.ProductResponse, error) {
+	var product *domain.Product
+	err := pi.DB.Transaction(func(tx *gorm.DB) error {
+		productRepo := pi.ProductRepository.WithTx(tx)
+
+		var err error
+		product, err = productRepo.GetById(id)
+		if err != nil {
+			return err
+		}
+		if err := req.ApplyToEntity(product); err != nil {
+			return err
+		}
+		if req.CategoryID != nil {
+			if err := product.CheckCategoryAssignable(); err != nil {
+				return err
+			}
+			if err := pi.assignCategory(pi.CategoryRepository.WithTx(tx), product, *req.CategoryID); err != nil {
+				return err
+			}
+			// 옵션 상품은 상위 상품의 카테고리를 따름
+			if err := pi.syncVariantCategory(productRepo, product); err != nil {
+				return err
+			}
+		}
+
+		var priceChange *domain.ProductPriceChange
+		if req.Price != nil {
+			if priceChange, err = product.ChangePrice(*req.Price, adminNumber); err != nil {
+				return err
+			}
+		}
+
+		if err := productRepo.Update(product); err != nil {
+			return err
+		}
+		if priceChange != nil {
+			return productRepo.CreatePriceChange(priceChange)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.NewProductResponse(product), nil
+}
+
+func (pi *ProductInteractor) GetPriceHistory(id int) ([]response.ProductPriceChangeResponse, error) {
 	product, err := pi.ProductRepository.GetById(id)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if err := product.UpdateStock(quantity); err != nil {
-		return err
+
+	changes, err := pi.ProductRepository.GetPriceChanges(product.ProductNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	changeResponses := make([]response.ProductPriceChangeResponse, 0, len(changes))
+	for _, change := range changes {
+		changeResponses = append(changeResponses, *response.NewProductPriceChangeResponse(change))
+	}
+
+	return changeResponses, nil
+}
+
+func (pi *ProductInteractor) UpdateStock(id int, req *request.UpdateStockRequest, adminNumber string) error {
+	return pi.DB.Transaction(func(tx *gorm.DB) error {
+		productRepo := pi.ProductRepository.WithTx(tx)
+
+		product, err := productRepo.GetById(id)
+		if err != nil {
+			return err
+		}
+		if err := product.CheckStockManaged(); err != nil {
+			return err
+		}
+		expected := product.StockQuantity
+		if req.ExpectedStockQuantity != nil {
+			if *req.ExpectedStockQuantity != product.StockQuantity {
+				return domain.ErrStockConflict
+			}
+			expected = *req.ExpectedStockQuantity
+		}
+		if err := product.UpdateStock(req.StockQuantity); err != nil {
+			return err
+		}
+		if req.StockQuantity == expected {
+			return nil
+		}
+
+		// 조회 이후 다른 요청이 재고를 바꿨다면 덮어쓰지 않도록 조회한 값을 조건으로 반영
+		movement, err := domain.NewStockMovement(product.ProductNumber, domain.StockMovementAdjustment, req.StockQuantity-expected, adminNumber, "")
+		if err != nil {
+			return err
+		}
+		return productRepo.ApplyStockMovementIfCurrent(movement, expected)
+	})
+}
+
+func (pi *ProductInteractor) AdjustStock(id int, req *request.AdjustStockRequest, adminNumber string) (*response.StockMovementResponse, error) {
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, errors.New("재고 조정 사유가 누락되었습니다.")
+	}
+
+	product, err := pi.ProductRepository.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := product.CheckStockManaged(); err != nil {
+		return nil, err
+	}
+
+	movement, err := domain.NewStockMovement(product.ProductNumber, domain.StockMovementAdjustment, req.Delta, adminNumber, "")
+	if err != nil {
+		return nil, err
+	}
+	movement.Reason = req.Reason
+
+	if err := pi.ProductRepository.ApplyStockMovement(movement); err != nil {
+		return nil, err
+	}
+
+	return response.NewStockMovementResponse(movement), nil
+}
+
+func (pi *ProductInteractor) GetStockMovements(id int) ([]response.StockMovementResponse, error) {
+	product, err := pi.ProductRepository.GetById(id)
+	if err != nil {
+		return nil, err
 	}
-	return pi.ProductRepository.Update(product)
+
+	movements, err := pi.ProductRepository.GetStockMovements(product.ProductNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	movementResponses := make([]response.StockMovementResponse, 0, len(movements))
+	for _, movement := range movements {
+		movementResponses = append(movementResponses, *response.NewStockMovementResponse(movement))
+	}
+
+	return movementResponses, nil
+}
+
+func (pi *ProductInteractor) ArchiveProduct(id int) (*response.ProductResponse, error) {
+	product, err := pi.ProductRepository.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := product.Archive(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := pi.ProductRepository.Update(product); err != nil {
+		return nil, err
+	}
+	return response.NewProductResponse(product), nil
+}
+
+func (pi *ProductInteractor) RestoreProduct(id int) (*response.ProductResponse, error) {
+	product, err := pi.ProductRepository.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := product.Restore(); err != nil {
+		return nil, err
+	}
+	if err := pi.ProductRepository.Update(product); err != nil {
+		return nil, err
+	}
+	return response.NewProductResponse(product), nil
 }
 
 func (pi *ProductInteractor) DeleteProduct(id int) error {
@@ -76,5 +340,106 @@ func (pi *ProductInteractor) DeleteProduct(id int) error {
 	if !canBeDeleted {
 		return errors.New("주문된 이력이 있어 삭제할 수 없습니다.")
 	}
+	variants, err := pi.ProductRepository.GetVariants(product.ProductNumber)
+	if err != nil {
+		return err
+	}
+	if len(variants) > 0 {
+		return errors.New("옵션 상품이 있어 삭제할 수 없습니다.")
+	}
 	return pi.ProductRepository.Delete(id)
 }
+
+func (pi *ProductInteractor) assignCategory(categoryRepo repository.CategoryRepository, product *domain.Product, categoryID int) error {
+	category, err := categoryRepo.GetById(categoryID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.New("존재하지 않는 카테고리입니다.")
+	}
+	if err != nil {
+		return err
+	}
+	product.AssignCategory(category)
+	return nil
+}
+
+func (pi *ProductInteractor) syncVariantCategory(productRepo repository.ProductRepository, parent *domain.Product) error {
+	if !parent.HasVariants() {
+		return nil
+	}
+	variants, err := productRepo.GetVariants(parent.ProductNumber)
+	if err != nil {
+		return err
+	}
+	for _, variant := range variants {
+		variant.CategoryID = parent.CategoryID
+		variant.Category = parent.Category
+		if err := productRepo.Update(variant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// categorySlugsWithDescendants 는 카테고리와 모든 하위 카테고리의 슬러그를 반환합니다.
+// 등록되지 않은 슬러그면 해당 값과 정확히 일치하는 상품만 조회하도록 그대로 반환합니다.
+func (pi *ProductInteractor) categorySlugsWithDescendants(slugs ...string) ([]string, error) {
+	categories, err := pi.CategoryRepository.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	idBySlug := make(map[string]int, len(categories))
+	slugByID := make(map[int]string, len(categories))
+	for _, category := range categories {
+		idBySlug[category.Slug] = category.ID
+		slugByID[category.ID] = category.Slug
+	}
+
+	var result []string
+	seen := make(map[string]bool)
+	add := func(slug string) {
+		if !seen[slug] {
+			seen[slug] = true
+			result = append(result, slug)
+		}
+	}
+	for _, slug := range slugs {
+		rootID, ok := idBySlug[slug]
+		if !ok {
+			add(slug)
+			continue
+		}
+		for _, id := range domain.CategoryDescendantIDs(categories, rootID) {
+			add(slugByID[id])
+		}
+	}
+	return result, nil
+}
+
+// checkOrderable 은 상품과, 옵션 상품이면 상위 상품까지 판매 중인지 확인합니다.
+func checkOrderable(productRepo repository.ProductRepository, product *domain.Product) error {
+	if err := product.CheckOrderable(); err != nil {
+		return err
+	}
+	if !product.IsVariant() {
+		return nil
+	}
+
+	parent, err := productRepo.GetByProductNumber(product.ParentProductNumber)
+	if err != nil {
+		return err
+	}
+	if parent.IsArchived() {
+		return domain.ErrProductArchived
+	}
+	return nil
+}
+
+// moveStock 은 재고를 변경하고 변동 이력을 함께 기록합니다.
+func moveStock(productRepo repository.ProductRepository, productNumber string, movementType domain.StockMovementType, delta int, actor, reference string) error {
+	movement, err := domain.NewStockMovement(productNumber, movementType, delta, actor, reference)
+	if err != nil {
+		return err
+	}
+	return productRepo.ApplyStockMovement(movement)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/HongJungWan/commerce-system/internal/domain"
 	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
 	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/response"
 	"github.com/HongJungWan/commerce-system/internal/usecases"
 	"github.com/HongJungWan/commerce-system/test/fixtures"
 	"github.com/stretchr/testify/assert"
@@ -15,17 +16,16 @@ func TestProductInteractor_CreateProduct_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	productRepo := repository.NewProductRepository(db)
-	interactor := usecases.NewProductInteractor(productRepo, db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
 
 	req := &request.CreateProductRequest{
 		ProductName:   "New Product",
-		Category:      "Electronics",
 		Price:         1000,
 		StockQuantity: 10,
 	}
 
 	// When
-	responseData, err := interactor.CreateProduct(req)
+	responseData, err := interactor.CreateProduct(req, "ADMIN1")
 
 	// Then
 	assert.NoError(t, err)
@@ -36,23 +36,28 @@ func TestProductInteractor_CreateProduct_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedProduct)
 	assert.Equal(t, "New Product", retrievedProduct.ProductName)
+	assert.Equal(t, 10, retrievedProduct.StockQuantity)
+
+	movements, _ := productRepo.GetStockMovements(retrievedProduct.ProductNumber)
+	assert.Len(t, movements, 1)
+	assert.Equal(t, domain.StockMovementReceipt, movements[0].Type)
+	assert.Equal(t, 10, movements[0].Balance)
 }
 
 func TestProductInteractor_CreateProduct_Failure_InvalidProduct(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	productRepo := repository.NewProductRepository(db)
-	interactor := usecases.NewProductInteractor(productRepo, db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
 
 	req := &request.CreateProductRequest{
-		ProductName:   "", // 상품명 누락
-		Category:      "Electronics",
+		ProductName:   "",    // 상품명 누락
 		Price:         -1000, // 잘못된 가격
 		StockQuantity: -10,   // 잘못된 재고 수량
 	}
 
 	// When
-	_, err := interactor.CreateProduct(req)
+	_, err := interactor.CreateProduct(req, "ADMIN1")
 
 	// Then
 	assert.Error(t, err)
@@ -63,7 +68,7 @@ func TestProductInteractor_GetProducts_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	productRepo := repository.NewProductRepository(db)
-	interactor := usecases.NewProductInteractor(productRepo, db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
 
 	product1 := &domain.Product{
 		ProductNumber: "P12345",
@@ -83,21 +88,411 @@ func TestProductInteractor_GetProducts_Success(t *testing.T) {
 	_ = productRepo.Create(product2)
 
 	// When
-	products, err := interactor.GetProducts(map[string]interface{}{
-		"category": "Electronics",
+	products, err := interactor.GetProducts(&domain.ProductQuery{
+		Categories: []string{"Electronics"},
 	})
 
 	// Then
 	assert.NoError(t, err)
-	assert.Len(t, products, 1)
-	assert.Equal(t, "Product One", products[0].ProductName)
+	assert.Len(t, products.Products, 1)
+	assert.EqualValues(t, 1, products.TotalCount)
+	assert.Equal(t, "Product One", products.Products[0].ProductName)
+}
+
+func TestProductInteractor_GetProducts_Success_Paginated(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	for _, name := range []string{"Pizza One", "Pizza Two", "Pizza Three", "Cola"} {
+		_, _ = interactor.CreateProduct(&request.CreateProductRequest{ProductName: name, Price: 1000, StockQuantity: 1}, "ADMIN1")
+	}
+	query := &domain.ProductQuery{Keyword: "pizza", Sort: domain.ProductSortNewest, Limit: 2}
+
+	// When
+	firstPage, err := interactor.GetProducts(query)
+	query.Cursor, _ = domain.DecodeProductCursor(firstPage.NextCursor)
+	secondPage, _ := interactor.GetProducts(query)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, firstPage.Products, 2)
+	assert.EqualValues(t, 3, firstPage.TotalCount)
+	assert.True(t, firstPage.HasNext)
+	assert.Equal(t, "Pizza Three", firstPage.Products[0].ProductName)
+	assert.Len(t, secondPage.Products, 1)
+	assert.EqualValues(t, 3, secondPage.TotalCount)
+	assert.False(t, secondPage.HasNext)
+	assert.Empty(t, secondPage.NextCursor)
+	assert.Equal(t, "Pizza One", secondPage.Products[0].ProductName)
+}
+
+func TestProductInteractor_CreateProduct_Success_AssignsCategory(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, categoryRepo, db)
+	category := &domain.Category{Name: "식품", Slug: "food"}
+	_ = categoryRepo.Create(category)
+
+	// When
+	responseData, err := interactor.CreateProduct(&request.CreateProductRequest{
+		ProductName:   "Pizza",
+		CategoryID:    &category.ID,
+		Price:         1000,
+		StockQuantity: 1,
+	}, "ADMIN1")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "food", responseData.Product.Category)
+	assert.Equal(t, category.ID, *responseData.Product.CategoryID)
+}
+
+func TestProductInteractor_CreateProduct_Failure_CategoryNotFound(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	categoryID := 999
+
+	// When
+	responseData, err := interactor.CreateProduct(&request.CreateProductRequest{
+		ProductName:   "Pizza",
+		CategoryID:    &categoryID,
+		Price:         1000,
+		StockQuantity: 1,
+	}, "ADMIN1")
+
+	// Then
+	assert.EqualError(t, err, "존재하지 않는 카테고리입니다.")
+	assert.Nil(t, responseData)
+}
+
+func TestProductInteractor_GetProducts_Success_IncludesDescendantCategories(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, categoryRepo, db)
+
+	food := &domain.Category{Name: "식품", Slug: "food"}
+	_ = categoryRepo.Create(food)
+	snacks := &domain.Category{ParentID: &food.ID, Name: "과자", Slug: "snacks"}
+	_ = categoryRepo.Create(snacks)
+	clothing := &domain.Category{Name: "의류", Slug: "clothing"}
+	_ = categoryRepo.Create(clothing)
+
+	for _, c := range []struct {
+		categoryID int
+		name       string
+	}{{food.ID, "Rice"}, {snacks.ID, "Chips"}, {clothing.ID, "Shirt"}} {
+		categoryID := c.categoryID
+		_, _ = interactor.CreateProduct(&request.CreateProductRequest{
+			ProductName:   c.name,
+			CategoryID:    &categoryID,
+			Price:         1000,
+			StockQuantity: 1,
+		}, "ADMIN1")
+	}
+
+	// When
+	foodProducts, err := interactor.GetProducts(&domain.ProductQuery{Categories: []string{"food"}})
+	snackProducts, _ := interactor.GetProducts(&domain.ProductQuery{Categories: []string{"snacks"}})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, foodProducts.Products, 2)
+	assert.Len(t, snackProducts.Products, 1)
+	assert.Equal(t, "Chips", snackProducts.Products[0].ProductName)
+}
+
+func createParentProduct(t *testing.T, interactor *usecases.ProductInteractor) *response.CreateProductResponse {
+	created, err := interactor.CreateProduct(&request.CreateProductRequest{
+		ProductName: "T-Shirt",
+		Price:       10000,
+		Options: []request.ProductOptionRequest{
+			{Name: "size", Values: []string{"S", "M"}},
+			{Name: "colour", Values: []string{"red", "blue"}},
+		},
+	}, "ADMIN1")
+	assert.NoError(t, err)
+	return created
+}
+
+func TestProductInteractor_AddVariant_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	parent := createParentProduct(t, interactor)
+
+	// When
+	variant, err := interactor.AddVariant(parent.Product.ID, &request.AddVariantRequest{
+		SKU:           "TSHIRT-RED-M",
+		OptionValues:  map[string]string{"size": "M", "colour": "red"},
+		StockQuantity: 5,
+	}, "ADMIN1")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, parent.Product.ProductNumber, variant.ParentProductNumber)
+	assert.Equal(t, "T-Shirt (M / red)", variant.ProductName)
+	assert.Equal(t, int64(10000), variant.Price)
+	assert.Equal(t, 5, variant.StockQuantity)
+
+	movements, _ := productRepo.GetStockMovements(variant.ProductNumber)
+	assert.Len(t, movements, 1)
+	assert.Equal(t, domain.StockMovementReceipt, movements[0].Type)
+}
+
+func TestProductInteractor_AddVariant_Failure_DuplicateOptions(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	parent := createParentProduct(t, interactor)
+	_, _ = interactor.AddVariant(parent.Product.ID, &request.AddVariantRequest{
+		SKU:          "TSHIRT-RED-M",
+		OptionValues: map[string]string{"size": "M", "colour": "red"},
+	}, "ADMIN1")
+
+	// When
+	variant, err := interactor.AddVariant(parent.Product.ID, &request.AddVariantRequest{
+		SKU:          "TSHIRT-RED-M-2",
+		OptionValues: map[string]string{"size": "M", "colour": "red"},
+	}, "ADMIN1")
+
+	// Then
+	assert.EqualError(t, err, "같은 옵션 조합의 옵션 상품이 이미 있습니다.")
+	assert.Nil(t, variant)
+}
+
+func TestProductInteractor_AddVariant_Failure_DuplicateSKU(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	parent := createParentProduct(t, interactor)
+	_, _ = interactor.AddVariant(parent.Product.ID, &request.AddVariantRequest{
+		SKU:          "TSHIRT-RED-M",
+		OptionValues: map[string]string{"size": "M", "colour": "red"},
+	}, "ADMIN1")
+
+	// When
+	variant, err := interactor.AddVariant(parent.Product.ID, &request.AddVariantRequest{
+		SKU:          "TSHIRT-RED-M",
+		OptionValues: map[string]string{"size": "S", "colour": "red"},
+	}, "ADMIN1")
+
+	// Then
+	assert.EqualError(t, err, "이미 사용 중인 SKU입니다.")
+	assert.Nil(t, variant)
+}
+
+func TestProductInteractor_GetProducts_Success_GroupsVariants(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	parent := createParentProduct(t, interactor)
+	_, _ = interactor.AddVariant(parent.Product.ID, &request.AddVariantRequest{
+		SKU:           "TSHIRT-RED-S",
+		OptionValues:  map[string]string{"size": "S", "colour": "red"},
+		StockQuantity: 0,
+	}, "ADMIN1")
+	_, _ = interactor.AddVariant(parent.Product.ID, &request.AddVariantRequest{
+		SKU:           "TSHIRT-BLUE-M",
+		OptionValues:  map[string]string{"size": "M", "colour": "blue"},
+		StockQuantity: 4,
+	}, "ADMIN1")
+
+	// When
+	products, err := interactor.GetProducts(&domain.ProductQuery{})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, products.Products, 1)
+	assert.Len(t, products.Products[0].Variants, 2)
+	assert.Equal(t, 4, products.Products[0].StockQuantity)
+	assert.True(t, products.Products[0].IsAvailable)
+	assert.False(t, products.Products[0].Variants[0].IsAvailable)
+	assert.True(t, products.Products[0].Variants[1].IsAvailable)
+}
+
+func TestProductInteractor_UpdateStock_Failure_ParentProduct(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+	parent := createParentProduct(t, interactor)
+
+	// When
+	err := interactor.UpdateStock(parent.Product.ID, &request.UpdateStockRequest{StockQuantity: 10}, "ADMIN1")
+
+	// Then
+	assert.EqualError(t, err, "옵션이 있는 상품은 옵션 상품별로 재고를 관리합니다.")
+}
+
+func TestProductInteractor_UpdateProduct_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Prodcut",
+		Category:      "Electronics",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+	name := "Test Product"
+	price := int64(1200)
+
+	// When
+	updated, err := interactor.UpdateProduct(product.ID, &request.UpdateProductRequest{ProductName: &name, Price: &price}, "ADMIN1")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "Test Product", updated.ProductName)
+	assert.Equal(t, "Electronics", updated.Category)
+	assert.Equal(t, int64(1200), updated.Price)
+	assert.Equal(t, 10, updated.StockQuantity)
+
+	history, _ := interactor.GetPriceHistory(product.ID)
+	assert.Len(t, history, 1)
+	assert.Equal(t, int64(1000), history[0].OldPrice)
+	assert.Equal(t, int64(1200), history[0].NewPrice)
+	assert.Equal(t, "ADMIN1", history[0].ChangedBy)
+}
+
+func TestProductInteractor_UpdateProduct_Success_CategoryFollowsToVariants(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, categoryRepo, db)
+	category := &domain.Category{Name: "의류", Slug: "clothing"}
+	_ = categoryRepo.Create(category)
+	parent := createParentProduct(t, interactor)
+	variant, _ := interactor.AddVariant(parent.Product.ID, &request.AddVariantRequest{
+		SKU:          "TSHIRT-RED-S",
+		OptionValues: map[string]string{"size": "S", "colour": "red"},
+	}, "ADMIN1")
+
+	// When
+	_, err := interactor.UpdateProduct(parent.Product.ID, &request.UpdateProductRequest{CategoryID: &category.ID}, "ADMIN1")
+
+	// Then
+	assert.NoError(t, err)
+	updatedVariant, _ := productRepo.GetById(variant.ID)
+	assert.Equal(t, "clothing", updatedVariant.Category)
+	assert.Equal(t, category.ID, *updatedVariant.CategoryID)
+}
+
+func TestProductInteractor_UpdateProduct_Failure_VariantCategory(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, categoryRepo, db)
+	category := &domain.Category{Name: "의류", Slug: "clothing"}
+	_ = categoryRepo.Create(category)
+	parent := createParentProduct(t, interactor)
+	variant, _ := interactor.AddVariant(parent.Product.ID, &request.AddVariantRequest{
+		SKU:          "TSHIRT-RED-S",
+		OptionValues: map[string]string{"size": "S", "colour": "red"},
+	}, "ADMIN1")
+
+	// When
+	_, err := interactor.UpdateProduct(variant.ID, &request.UpdateProductRequest{CategoryID: &category.ID}, "ADMIN1")
+
+	// Then
+	assert.EqualError(t, err, "옵션 상품의 카테고리는 상위 상품에서 변경해 주세요.")
+	unchangedVariant, _ := productRepo.GetById(variant.ID)
+	assert.Nil(t, unchangedVariant.CategoryID)
+}
+
+func TestProductInteractor_UpdateProduct_Success_SamePriceNotRecorded(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+	price := int64(1000)
+
+	// When
+	_, err := interactor.UpdateProduct(product.ID, &request.UpdateProductRequest{Price: &price}, "ADMIN1")
+
+	// Then
+	assert.NoError(t, err)
+	history, _ := interactor.GetPriceHistory(product.ID)
+	assert.Empty(t, history)
+}
+
+func TestProductInteractor_UpdateProduct_Failure_InvalidPrice(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+	price := int64(-1)
+
+	// When
+	updated, err := interactor.UpdateProduct(product.ID, &request.UpdateProductRequest{Price: &price}, "ADMIN1")
+
+	// Then
+	assert.EqualError(t, err, "가격이 잘못되었습니다.")
+	assert.Nil(t, updated)
+	unchangedProduct, _ := productRepo.GetById(product.ID)
+	assert.Equal(t, int64(1000), unchangedProduct.Price)
+}
+
+func TestProductInteractor_UpdateProduct_Failure_EmptyName(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+	name := ""
+
+	// When
+	updated, err := interactor.UpdateProduct(product.ID, &request.UpdateProductRequest{ProductName: &name}, "ADMIN1")
+
+	// Then
+	assert.EqualError(t, err, "상품명이 누락되었습니다.")
+	assert.Nil(t, updated)
 }
 
 func TestProductInteractor_UpdateStock_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	productRepo := repository.NewProductRepository(db)
-	interactor := usecases.NewProductInteractor(productRepo, db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
 
 	product := &domain.Product{
 		ProductNumber: "P12345",
@@ -108,32 +503,239 @@ func TestProductInteractor_UpdateStock_Success(t *testing.T) {
 	_ = productRepo.Create(product)
 
 	// When
-	err := interactor.UpdateStock(product.ID, 20)
+	err := interactor.UpdateStock(product.ID, &request.UpdateStockRequest{StockQuantity: 20}, "ADMIN1")
 
 	// Then
 	assert.NoError(t, err)
 	updatedProduct, _ := productRepo.GetById(product.ID)
 	assert.Equal(t, 20, updatedProduct.StockQuantity)
+
+	movements, _ := productRepo.GetStockMovements("P12345")
+	assert.Len(t, movements, 1)
+	assert.Equal(t, domain.StockMovementAdjustment, movements[0].Type)
+	assert.Equal(t, 10, movements[0].Delta)
+	assert.Equal(t, "ADMIN1", movements[0].Actor)
+}
+
+func TestProductInteractor_GetStockMovements_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+
+	created, _ := interactor.CreateProduct(&request.CreateProductRequest{
+		ProductName:   "New Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}, "ADMIN1")
+	_ = interactor.UpdateStock(created.Product.ID, &request.UpdateStockRequest{StockQuantity: 7}, "ADMIN2")
+
+	// When
+	movements, err := interactor.GetStockMovements(created.Product.ID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, movements, 2)
+	assert.Equal(t, "receipt", movements[0].Type)
+	assert.Equal(t, 10, movements[0].Balance)
+	assert.Equal(t, "adjustment", movements[1].Type)
+	assert.Equal(t, -3, movements[1].Delta)
+	assert.Equal(t, 7, movements[1].Balance)
+	assert.Equal(t, "ADMIN2", movements[1].Actor)
 }
 
 func TestProductInteractor_UpdateStock_Failure_ProductNotFound(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	productRepo := repository.NewProductRepository(db)
-	interactor := usecases.NewProductInteractor(productRepo, db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
 
 	// When
-	err := interactor.UpdateStock(9999, 20) // 존재하지 않는 ID
+	err := interactor.UpdateStock(9999, &request.UpdateStockRequest{StockQuantity: 20}, "ADMIN1") // 존재하지 않는 ID
 
 	// Then
 	assert.Error(t, err)
 }
 
+func TestProductInteractor_UpdateStock_Failure_ExpectedQuantityMismatch(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+	expected := 8
+
+	// When
+	err := interactor.UpdateStock(product.ID, &request.UpdateStockRequest{StockQuantity: 20, ExpectedStockQuantity: &expected}, "ADMIN1")
+
+	// Then
+	assert.ErrorIs(t, err, domain.ErrStockConflict)
+	unchangedProduct, _ := productRepo.GetById(product.ID)
+	assert.Equal(t, 10, unchangedProduct.StockQuantity)
+	movements, _ := productRepo.GetStockMovements("P12345")
+	assert.Empty(t, movements)
+}
+
+func TestProductInteractor_AdjustStock_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	// When
+	movement, err := interactor.AdjustStock(product.ID, &request.AdjustStockRequest{Delta: -3, Reason: "파손 상품 폐기"}, "ADMIN1")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, -3, movement.Delta)
+	assert.Equal(t, 7, movement.Balance)
+	assert.Equal(t, "파손 상품 폐기", movement.Reason)
+	updatedProduct, _ := productRepo.GetById(product.ID)
+	assert.Equal(t, 7, updatedProduct.StockQuantity)
+}
+
+func TestProductInteractor_AdjustStock_Failure_NegativeResult(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 2,
+	}
+	_ = productRepo.Create(product)
+
+	// When
+	movement, err := interactor.AdjustStock(product.ID, &request.AdjustStockRequest{Delta: -3, Reason: "재고 실사"}, "ADMIN1")
+
+	// Then
+	assert.ErrorIs(t, err, domain.ErrInsufficientStock)
+	assert.Nil(t, movement)
+	unchangedProduct, _ := productRepo.GetById(product.ID)
+	assert.Equal(t, 2, unchangedProduct.StockQuantity)
+}
+
+func TestProductInteractor_AdjustStock_Failure_MissingReason(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	// When
+	movement, err := interactor.AdjustStock(product.ID, &request.AdjustStockRequest{Delta: 5}, "ADMIN1")
+
+	// Then
+	assert.EqualError(t, err, "재고 조정 사유가 누락되었습니다.")
+	assert.Nil(t, movement)
+}
+
+func TestProductInteractor_ArchiveProduct_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	// When
+	archived, err := interactor.ArchiveProduct(product.ID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.NotEmpty(t, archived.ArchivedAt)
+
+	products, _ := interactor.GetProducts(&domain.ProductQuery{})
+	assert.Empty(t, products.Products)
+	storedProduct, err := productRepo.GetByProductNumber("P12345")
+	assert.NoError(t, err)
+	assert.True(t, storedProduct.IsArchived())
+	assert.Equal(t, 10, storedProduct.StockQuantity)
+}
+
+func TestProductInteractor_RestoreProduct_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+	_, _ = interactor.ArchiveProduct(product.ID)
+
+	// When
+	restored, err := interactor.RestoreProduct(product.ID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Empty(t, restored.ArchivedAt)
+	products, _ := interactor.GetProducts(&domain.ProductQuery{})
+	assert.Len(t, products.Products, 1)
+}
+
+func TestProductInteractor_RestoreProduct_Failure_NotArchived(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 10,
+	}
+	_ = productRepo.Create(product)
+
+	// When
+	restored, err := interactor.RestoreProduct(product.ID)
+
+	// Then
+	assert.EqualError(t, err, "보관 처리되지 않은 상품입니다.")
+	assert.Nil(t, restored)
+}
+
 func TestProductInteractor_DeleteProduct_Success(t *testing.T) {
 	// Given
 	db := fixtures.SetupTestDB()
 	productRepo := repository.NewProductRepository(db)
-	interactor := usecases.NewProductInteractor(productRepo, db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
 
 	product := &domain.Product{
 		ProductNumber: "P12345",
@@ -158,7 +760,7 @@ func TestProductInteractor_DeleteProduct_Failure_HasOrders(t *testing.T) {
 	db := fixtures.SetupTestDB()
 	productRepo := repository.NewProductRepository(db)
 	orderRepo := repository.NewOrderRepository(db) // OrderRepository가 정의되어 있다고 가정
-	interactor := usecases.NewProductInteractor(productRepo, db)
+	interactor := usecases.NewProductInteractor(productRepo, repository.NewCategoryRepository(db), db)
 
 	product := &domain.Product{
 		ProductNumber: "P12345",
@@ -169,8 +771,8 @@ func TestProductInteractor_DeleteProduct_Failure_HasOrders(t *testing.T) {
 	_ = productRepo.Create(product)
 
 	order := &domain.Order{
-		OrderNumber:   "O12345",
-		ProductNumber: product.ProductNumber,
+		OrderNumber: "O12345",
+		Items:       []domain.OrderItem{{ProductNumber: product.ProductNumber}},
 	}
 	_ = orderRepo.Create(order)
 
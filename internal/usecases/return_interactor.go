@@ -0,0 +1,160 @@
+package usecases
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/domain/gateway"
+	"github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/response"
+	"gorm.io/gorm"
+)
+
+type ReturnInteractor struct {
+	ReturnRequestRepository repository.ReturnRequestRepository
+	OrderRepository         repository.OrderRepository
+	ProductRepository       repository.ProductRepository
+	PaymentGateway          gateway.PaymentGateway
+	DB                      *gorm.DB
+}
+
+func NewReturnInteractor(rr repository.ReturnRequestRepository, or repository.OrderRepository, pr repository.ProductRepository, pg gateway.PaymentGateway, db *gorm.DB) *ReturnInteractor {
+	return &ReturnInteractor{
+		ReturnRequestRepository: rr,
+		OrderRepository:         or,
+		ProductRepository:       pr,
+		PaymentGateway:          pg,
+		DB:                      db,
+	}
+}
+
+func (ri *ReturnInteractor) RequestReturn(orderId int, memberNumber string, req *request.CreateReturnRequest) (*response.ReturnResponse, error) {
+	var returnRequest *domain.ReturnRequest
+	err := ri.DB.Transaction(func(tx *gorm.DB) error {
+		orderRepo := ri.OrderRepository.WithTx(tx)
+
+		order, err := orderRepo.GetByIdForUpdate(orderId)
+		if err != nil {
+			return err
+		}
+
+		if order.MemberNumber != memberNumber {
+			return errors.New("해당 주문에 대한 권한이 없습니다.")
+		}
+
+		returnRequest, err = order.RequestReturn(req.ProductNumber, req.Quantity, req.Reason)
+		if err != nil {
+			return err
+		}
+
+		if err := orderRepo.Update(order); err != nil {
+			return err
+		}
+		return ri.ReturnRequestRepository.WithTx(tx).Create(returnRequest)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.NewReturnResponse(returnRequest), nil
+}
+
+func (ri *ReturnInteractor) GetMyReturns(memberNumber string) ([]response.ReturnResponse, error) {
+	returnRequests, err := ri.ReturnRequestRepository.GetByMemberNumber(memberNumber)
+	if err != nil {
+		return nil, err
+	}
+	return toReturnResponses(returnRequests), nil
+}
+
+func (ri *ReturnInteractor) GetReturns(status string) ([]response.ReturnResponse, error) {
+	var returnStatus domain.ReturnStatus
+	if status != "" {
+		parsed, err := domain.ParseReturnStatus(status)
+		if err != nil {
+			return nil, err
+		}
+		returnStatus = parsed
+	}
+
+	returnRequests, err := ri.ReturnRequestRepository.GetByStatus(returnStatus)
+	if err != nil {
+		return nil, err
+	}
+	return toReturnResponses(returnRequests), nil
+}
+
+func (ri *ReturnInteractor) ChangeReturnStatus(returnId int, adminNumber string, req *request.UpdateReturnStatusRequest) (*response.ReturnResponse, error) {
+	status, err := domain.ParseReturnStatus(req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	var returnRequest *domain.ReturnRequest
+	var payment *domain.Payment
+	err = ri.DB.Transaction(func(tx *gorm.DB) error {
+		returnRepo := ri.ReturnRequestRepository.WithTx(tx)
+		orderRepo := ri.OrderRepository.WithTx(tx)
+
+		returnRequest, err = returnRepo.GetByIdForUpdate(returnId)
+		if err != nil {
+			return err
+		}
+		if err := returnRequest.ChangeStatus(status); err != nil {
+			return err
+		}
+
+		switch returnRequest.Status {
+		case domain.ReturnStatusRejected:
+			// 거절된 수량은 다시 반품할 수 있도록 복원
+			order, err := orderRepo.GetByIdForUpdate(returnRequest.OrderID)
+			if err != nil {
+				return err
+			}
+			order.ReleaseReturn(returnRequest)
+			if err := orderRepo.Update(order); err != nil {
+				return err
+			}
+		case domain.ReturnStatusReceived:
+			// 입고된 반품 상품을 재고로 복원
+			reference := strconv.Itoa(returnRequest.ID)
+			if err := moveStock(ri.ProductRepository.WithTx(tx), returnRequest.ProductNumber, domain.StockMovementReturn, returnRequest.Quantity, adminNumber, reference); err != nil {
+				return err
+			}
+		case domain.ReturnStatusRefunded:
+			order, err := orderRepo.GetByIdForUpdate(returnRequest.OrderID)
+			if err != nil {
+				return err
+			}
+			if order.Payment != nil {
+				payment = order.Payment
+				if err := refundPayment(payment, returnRequest.Amount); err != nil {
+					return err
+				}
+				if err := orderRepo.Update(order); err != nil {
+					return err
+				}
+			}
+		}
+
+		return returnRepo.Update(returnRequest)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := settleRefund(ri.PaymentGateway, ri.OrderRepository, payment, returnRequest.Amount); err != nil {
+		return nil, err
+	}
+
+	return response.NewReturnResponse(returnRequest), nil
+}
+
+func toReturnResponses(returnRequests []*domain.ReturnRequest) []response.ReturnResponse {
+	returnResponses := make([]response.ReturnResponse, 0, len(returnRequests))
+	for _, returnRequest := range returnRequests {
+		returnResponses = append(returnResponses, *response.NewReturnResponse(returnRequest))
+	}
+	return returnResponses
+}
@@ -0,0 +1,201 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/gateway"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReturnInteractor_RequestReturn_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	returnRepo := repository.NewReturnRequestRepository(db)
+	orderRepo := repository.NewOrderRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewReturnInteractor(returnRepo, orderRepo, productRepo, gateway.NewFakePaymentGateway(), db)
+
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusDelivered,
+	}
+	_ = orderRepo.Create(order)
+
+	// When
+	responseData, err := interactor.RequestReturn(12345, "M12345", &request.CreateReturnRequest{
+		ProductNumber: "P12345",
+		Quantity:      1,
+		Reason:        "파손",
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, string(domain.ReturnStatusRequested), responseData.Status)
+	assert.EqualValues(t, 1000, responseData.Amount)
+
+	updatedOrder, _ := orderRepo.GetById(12345)
+	assert.Equal(t, 1, updatedOrder.Items[0].ReturnedQuantity)
+}
+
+func TestReturnInteractor_RequestReturn_Failure_Unauthorized(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	returnRepo := repository.NewReturnRequestRepository(db)
+	orderRepo := repository.NewOrderRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewReturnInteractor(returnRepo, orderRepo, productRepo, gateway.NewFakePaymentGateway(), db)
+
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusDelivered,
+	}
+	_ = orderRepo.Create(order)
+
+	// When
+	_, err := interactor.RequestReturn(12345, "M67890", &request.CreateReturnRequest{
+		ProductNumber: "P12345",
+		Quantity:      1,
+		Reason:        "파손",
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "해당 주문에 대한 권한이 없습니다.", err.Error())
+}
+
+func TestReturnInteractor_ChangeReturnStatus_Success_ReceiveAndRefund(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	returnRepo := repository.NewReturnRequestRepository(db)
+	orderRepo := repository.NewOrderRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	paymentGateway := gateway.NewFakePaymentGateway()
+	interactor := usecases.NewReturnInteractor(returnRepo, orderRepo, productRepo, paymentGateway, db)
+
+	product := &domain.Product{
+		ProductNumber: "P12345",
+		ProductName:   "Test Product",
+		Price:         1000,
+		StockQuantity: 5,
+	}
+	_ = productRepo.Create(product)
+
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusDelivered,
+	}
+	transactionID, _ := paymentGateway.Authorize("O12345", 2000)
+	_ = paymentGateway.Capture(transactionID, 2000)
+	order.Payment = domain.NewAuthorizedPayment(paymentGateway.Provider(), transactionID, 2000)
+	_ = order.Payment.Capture()
+	_ = orderRepo.Create(order)
+
+	created, _ := interactor.RequestReturn(12345, "M12345", &request.CreateReturnRequest{
+		ProductNumber: "P12345",
+		Quantity:      1,
+		Reason:        "파손",
+	})
+
+	// When
+	_, approveErr := interactor.ChangeReturnStatus(created.ID, "ADMIN1", &request.UpdateReturnStatusRequest{Status: "approved"})
+	_, receiveErr := interactor.ChangeReturnStatus(created.ID, "ADMIN1", &request.UpdateReturnStatusRequest{Status: "received"})
+	responseData, refundErr := interactor.ChangeReturnStatus(created.ID, "ADMIN1", &request.UpdateReturnStatusRequest{Status: "refunded"})
+
+	// Then
+	assert.NoError(t, approveErr)
+	assert.NoError(t, receiveErr)
+	assert.NoError(t, refundErr)
+	assert.Equal(t, string(domain.ReturnStatusRefunded), responseData.Status)
+
+	updatedProduct, _ := productRepo.GetByProductNumber("P12345")
+	assert.Equal(t, 6, updatedProduct.StockQuantity)
+
+	updatedOrder, _ := orderRepo.GetById(12345)
+	assert.EqualValues(t, 1000, updatedOrder.Payment.RefundedAmount)
+	assert.Equal(t, domain.PaymentStatusPartiallyRefunded, updatedOrder.Payment.Status)
+}
+
+func TestReturnInteractor_ChangeReturnStatus_Success_RejectReleasesQuantity(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	returnRepo := repository.NewReturnRequestRepository(db)
+	orderRepo := repository.NewOrderRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewReturnInteractor(returnRepo, orderRepo, productRepo, gateway.NewFakePaymentGateway(), db)
+
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusDelivered,
+	}
+	_ = orderRepo.Create(order)
+
+	created, _ := interactor.RequestReturn(12345, "M12345", &request.CreateReturnRequest{
+		ProductNumber: "P12345",
+		Quantity:      2,
+		Reason:        "단순 변심",
+	})
+
+	// When
+	responseData, err := interactor.ChangeReturnStatus(created.ID, "ADMIN1", &request.UpdateReturnStatusRequest{Status: "rejected"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, string(domain.ReturnStatusRejected), responseData.Status)
+
+	updatedOrder, _ := orderRepo.GetById(12345)
+	assert.Equal(t, 0, updatedOrder.Items[0].ReturnedQuantity)
+}
+
+func TestReturnInteractor_ChangeReturnStatus_Failure_InvalidTransition(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	returnRepo := repository.NewReturnRequestRepository(db)
+	orderRepo := repository.NewOrderRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	interactor := usecases.NewReturnInteractor(returnRepo, orderRepo, productRepo, gateway.NewFakePaymentGateway(), db)
+
+	returnRequest := &domain.ReturnRequest{
+		OrderID:       12345,
+		MemberNumber:  "M12345",
+		ProductNumber: "P12345",
+		Quantity:      1,
+		Amount:        1000,
+		Reason:        "파손",
+		Status:        domain.ReturnStatusRequested,
+		RequestedAt:   time.Now(),
+	}
+	_ = returnRepo.Create(returnRequest)
+
+	// When
+	_, err := interactor.ChangeReturnStatus(returnRequest.ID, "ADMIN1", &request.UpdateReturnStatusRequest{Status: "refunded"})
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "입고된 반품만 환불할 수 있습니다.", err.Error())
+}
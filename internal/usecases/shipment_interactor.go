@@ -0,0 +1,200 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/domain/gateway"
+	"github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/response"
+	"gorm.io/gorm"
+)
+
+type ShipmentInteractor struct {
+	ShipmentRepository repository.ShipmentRepository
+	OrderRepository    repository.OrderRepository
+	CarrierGateway     gateway.CarrierGateway
+	PaymentGateway     gateway.PaymentGateway
+	DB                 *gorm.DB
+}
+
+func NewShipmentInteractor(sr repository.ShipmentRepository, or repository.OrderRepository, cg gateway.CarrierGateway, pg gateway.PaymentGateway, db *gorm.DB) *ShipmentInteractor {
+	return &ShipmentInteractor{
+		ShipmentRepository: sr,
+		OrderRepository:    or,
+		CarrierGateway:     cg,
+		PaymentGateway:     pg,
+		DB:                 db,
+	}
+}
+
+func (si *ShipmentInteractor) CreateShipment(orderId int) (*response.ShipmentResponse, error) {
+	order, err := si.OrderRepository.GetById(orderId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkShippable(order); err != nil {
+		return nil, err
+	}
+
+	// 운송장 등록은 외부 호출이므로 트랜잭션 밖에서 먼저 처리
+	trackingNumber, err := si.CarrierGateway.Register(order.OrderNumber)
+	if err != nil {
+		return nil, err
+	}
+	shipment, err := domain.NewShipment(order.ID, si.CarrierGateway.Carrier(), trackingNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	err = si.DB.Transaction(func(tx *gorm.DB) error {
+		orderRepo := si.OrderRepository.WithTx(tx)
+
+		order, err := orderRepo.GetByIdForUpdate(orderId)
+		if err != nil {
+			return err
+		}
+		if err := checkShippable(order); err != nil {
+			return err
+		}
+
+		// 첫 배송이 시작되면 아직 매입하지 않은 결제를 매입하고 주문을 배송 중으로 변경
+		if order.Status != domain.OrderStatusShipped {
+			if order.Status == domain.OrderStatusPending {
+				if err := payOrder(si.PaymentGateway, order); err != nil {
+					return err
+				}
+			}
+			if err := order.Ship(); err != nil {
+				return err
+			}
+			if err := orderRepo.Update(order); err != nil {
+				return err
+			}
+		}
+		return si.ShipmentRepository.WithTx(tx).Create(shipment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.NewShipmentResponse(shipment), nil
+}
+
+func (si *ShipmentInteractor) DeliverShipment(id int) (*response.ShipmentResponse, error) {
+	return si.deliver(id, time.Now())
+}
+
+// 택배사의 배송 조회 결과가 배송 완료이면 배송 완료로 처리
+func (si *ShipmentInteractor) SyncTracking(id int) (*response.ShipmentResponse, error) {
+	shipment, err := si.ShipmentRepository.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+	if shipment.IsDelivered() {
+		return response.NewShipmentResponse(shipment), nil
+	}
+
+	info, err := si.CarrierGateway.Track(shipment.TrackingNumber)
+	if err != nil {
+		return nil, err
+	}
+	if !info.Delivered {
+		return response.NewShipmentResponse(shipment), nil
+	}
+
+	deliveredAt := time.Now()
+	if info.DeliveredAt != nil {
+		deliveredAt = *info.DeliveredAt
+	}
+	return si.deliver(id, deliveredAt)
+}
+
+func (si *ShipmentInteractor) GetOrderShipments(orderId int, memberNumber string) ([]response.ShipmentResponse, error) {
+	order, err := si.OrderRepository.GetById(orderId)
+	if err != nil {
+		return nil, err
+	}
+	if order.MemberNumber != memberNumber {
+		return nil, errors.New("해당 주문에 대한 권한이 없습니다.")
+	}
+
+	shipments, err := si.ShipmentRepository.GetByOrderID(orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	shipmentResponses := make([]response.ShipmentResponse, 0, len(shipments))
+	for _, shipment := range shipments {
+		shipmentResponses = append(shipmentResponses, *response.NewShipmentResponse(shipment))
+	}
+	return shipmentResponses, nil
+}
+
+func (si *ShipmentInteractor) GetOrdersAwaitingShipment() ([]response.OrderResponse, error) {
+	orders, err := si.OrderRepository.GetByStatus(domain.OrderStatusPending, domain.OrderStatusPaid)
+	if err != nil {
+		return nil, err
+	}
+
+	orderResponses := make([]response.OrderResponse, 0, len(orders))
+	for _, order := range orders {
+		orderResponses = append(orderResponses, *response.NewOrderResponse(order))
+	}
+	return orderResponses, nil
+}
+
+func (si *ShipmentInteractor) deliver(id int, deliveredAt time.Time) (*response.ShipmentResponse, error) {
+	var shipment *domain.Shipment
+	err := si.DB.Transaction(func(tx *gorm.DB) error {
+		shipmentRepo := si.ShipmentRepository.WithTx(tx)
+		orderRepo := si.OrderRepository.WithTx(tx)
+
+		var err error
+		shipment, err = shipmentRepo.GetByIdForUpdate(id)
+		if err != nil {
+			return err
+		}
+		if err := shipment.Deliver(deliveredAt); err != nil {
+			return err
+		}
+		if err := shipmentRepo.Update(shipment); err != nil {
+			return err
+		}
+
+		// 주문의 모든 배송이 완료되면 주문을 배송 완료로 변경
+		shipments, err := shipmentRepo.GetByOrderID(shipment.OrderID)
+		if err != nil {
+			return err
+		}
+		for _, other := range shipments {
+			if !other.IsDelivered() {
+				return nil
+			}
+		}
+		order, err := orderRepo.GetByIdForUpdate(shipment.OrderID)
+		if err != nil {
+			return err
+		}
+		if order.Status != domain.OrderStatusShipped {
+			return nil
+		}
+		if err := order.Deliver(); err != nil {
+			return err
+		}
+		return orderRepo.Update(order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.NewShipmentResponse(shipment), nil
+}
+
+func checkShippable(order *domain.Order) error {
+	if order.Status != domain.OrderStatusPending && order.Status != domain.OrderStatusPaid && order.Status != domain.OrderStatusShipped {
+		return errors.New("배송 전이거나 배송 중인 주문만 배송을 등록할 수 있습니다.")
+	}
+	return nil
+}
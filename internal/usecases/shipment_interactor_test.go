@@ -0,0 +1,149 @@
+package usecases_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/gateway"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func newShipmentTestOrder(db *gorm.DB, paymentGateway *gateway.FakePaymentGateway) *domain.Order {
+	transactionID, _ := paymentGateway.Authorize("O12345", 2000)
+	order := &domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusPending,
+		Payment:      domain.NewAuthorizedPayment(paymentGateway.Provider(), transactionID, 2000),
+	}
+	_ = repository.NewOrderRepository(db).Create(order)
+	return order
+}
+
+func TestShipmentInteractor_CreateShipment_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	paymentGateway := gateway.NewFakePaymentGateway()
+	interactor := usecases.NewShipmentInteractor(repository.NewShipmentRepository(db), orderRepo, gateway.NewFakeCarrierGateway(), paymentGateway, db)
+	newShipmentTestOrder(db, paymentGateway)
+
+	// When
+	responseData, err := interactor.CreateShipment(12345)
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(responseData.TrackingNumber, "FAKE-O12345-"))
+	assert.Equal(t, string(domain.ShipmentStatusInTransit), responseData.Status)
+
+	updatedOrder, _ := orderRepo.GetById(12345)
+	assert.Equal(t, domain.OrderStatusShipped, updatedOrder.Status)
+	assert.Equal(t, domain.PaymentStatusCaptured, updatedOrder.Payment.Status)
+}
+
+func TestShipmentInteractor_CreateShipment_Failure_NotPaid(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	interactor := usecases.NewShipmentInteractor(repository.NewShipmentRepository(db), orderRepo, gateway.NewFakeCarrierGateway(), gateway.NewFakePaymentGateway(), db)
+	_ = orderRepo.Create(&domain.Order{
+		ID:           12345,
+		OrderNumber:  "O12345",
+		OrderDate:    time.Now(),
+		MemberNumber: "M12345",
+		Items:        []domain.OrderItem{{ProductNumber: "P12345", Price: 1000, Quantity: 2, LineTotal: 2000}},
+		TotalAmount:  2000,
+		Status:       domain.OrderStatusCanceled,
+	})
+
+	// When
+	_, err := interactor.CreateShipment(12345)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "배송 전이거나 배송 중인 주문만 배송을 등록할 수 있습니다.", err.Error())
+}
+
+func TestShipmentInteractor_SyncTracking_Success_Delivered(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	paymentGateway := gateway.NewFakePaymentGateway()
+	carrierGateway := gateway.NewFakeCarrierGateway()
+	interactor := usecases.NewShipmentInteractor(repository.NewShipmentRepository(db), orderRepo, carrierGateway, paymentGateway, db)
+	newShipmentTestOrder(db, paymentGateway)
+	shipment, _ := interactor.CreateShipment(12345)
+	_ = carrierGateway.SimulateDelivery(shipment.TrackingNumber)
+
+	// When
+	responseData, err := interactor.SyncTracking(shipment.ID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, string(domain.ShipmentStatusDelivered), responseData.Status)
+
+	updatedOrder, _ := orderRepo.GetById(12345)
+	assert.Equal(t, domain.OrderStatusDelivered, updatedOrder.Status)
+}
+
+func TestShipmentInteractor_DeliverShipment_Success_WaitsForAllShipments(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	paymentGateway := gateway.NewFakePaymentGateway()
+	interactor := usecases.NewShipmentInteractor(repository.NewShipmentRepository(db), orderRepo, gateway.NewFakeCarrierGateway(), paymentGateway, db)
+	newShipmentTestOrder(db, paymentGateway)
+	first, _ := interactor.CreateShipment(12345)
+	_, _ = interactor.CreateShipment(12345)
+
+	// When
+	_, err := interactor.DeliverShipment(first.ID)
+
+	// Then
+	assert.NoError(t, err)
+	updatedOrder, _ := orderRepo.GetById(12345)
+	assert.Equal(t, domain.OrderStatusShipped, updatedOrder.Status)
+}
+
+func TestShipmentInteractor_GetOrderShipments_Failure_Unauthorized(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	paymentGateway := gateway.NewFakePaymentGateway()
+	interactor := usecases.NewShipmentInteractor(repository.NewShipmentRepository(db), orderRepo, gateway.NewFakeCarrierGateway(), paymentGateway, db)
+	newShipmentTestOrder(db, paymentGateway)
+
+	// When
+	_, err := interactor.GetOrderShipments(12345, "M67890")
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "해당 주문에 대한 권한이 없습니다.", err.Error())
+}
+
+func TestShipmentInteractor_GetOrdersAwaitingShipment_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	orderRepo := repository.NewOrderRepository(db)
+	paymentGateway := gateway.NewFakePaymentGateway()
+	interactor := usecases.NewShipmentInteractor(repository.NewShipmentRepository(db), orderRepo, gateway.NewFakeCarrierGateway(), paymentGateway, db)
+	newShipmentTestOrder(db, paymentGateway)
+
+	// When
+	responseData, err := interactor.GetOrdersAwaitingShipment()
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, responseData, 1)
+	assert.Equal(t, "O12345", responseData[0].OrderNumber)
+}
@@ -0,0 +1,25 @@
+package usecases
+
+import "github.com/HongJungWan/commerce-system/internal/domain"
+
+type rateTableShippingCalculator struct {
+	rateTable *domain.ShippingRateTable
+}
+
+// 배송지 우편번호로 권역을 찾고, 주문 상품의 배송 무게 합계로 배송비를 계산
+func NewRateTableShippingCalculator(rateTable *domain.ShippingRateTable) ShippingCalculator {
+	return &rateTableShippingCalculator{rateTable: rateTable}
+}
+
+func (sc *rateTableShippingCalculator) CalculateShipping(input *PricingInput, merchandiseAmount int64) (int64, error) {
+	var weight int
+	for _, item := range input.Items {
+		weight += sc.rateTable.ChargeableWeight(item.Product) * item.Quantity
+	}
+
+	var zipCode string
+	if input.ShippingAddress != nil {
+		zipCode = input.ShippingAddress.ZipCode
+	}
+	return sc.rateTable.CalculateFee(zipCode, weight, merchandiseAmount)
+}
@@ -0,0 +1,54 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRateTable() *domain.ShippingRateTable {
+	return &domain.ShippingRateTable{
+		FreeShippingThreshold: 50000,
+		DefaultZone:           "mainland",
+		Zones: []domain.ShippingZone{
+			{Name: "mainland", Rates: []domain.ShippingWeightRate{{MaxWeight: 2000, Fee: 3000}, {MaxWeight: 5000, Fee: 4000}}},
+			{Name: "jeju", ZipPrefixes: []string{"63"}, Rates: []domain.ShippingWeightRate{{MaxWeight: 5000, Fee: 7000}}},
+		},
+	}
+}
+
+func TestRateTableShippingCalculator_CalculateShipping_SumsItemWeights(t *testing.T) {
+	// Given
+	calculator := usecases.NewRateTableShippingCalculator(newTestRateTable())
+	input := &usecases.PricingInput{
+		Items: []usecases.PricingItem{
+			{Product: &domain.Product{Weight: 800}, Quantity: 2},
+			{Product: &domain.Product{Weight: 1000}, Quantity: 1},
+		},
+	}
+
+	// When
+	fee, err := calculator.CalculateShipping(input, 10000)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4000, fee)
+}
+
+func TestRateTableShippingCalculator_CalculateShipping_UsesShippingAddress(t *testing.T) {
+	// Given
+	calculator := usecases.NewRateTableShippingCalculator(newTestRateTable())
+	input := &usecases.PricingInput{
+		ShippingAddress: &domain.PostalAddress{ZipCode: "63100"},
+		Items:           []usecases.PricingItem{{Product: &domain.Product{Weight: 800}, Quantity: 1}},
+	}
+
+	// When
+	fee, err := calculator.CalculateShipping(input, 10000)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 7000, fee)
+}
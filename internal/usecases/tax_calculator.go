@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/domain/repository"
+)
+
+type taxClassCalculator struct {
+	taxClassRepository repository.TaxClassRepository
+}
+
+// 상품 카테고리에 지정된 과세 분류로 세금을 계산하고, 지정된 분류가 없으면 기본 분류를 적용
+func NewTaxClassCalculator(tr repository.TaxClassRepository) TaxCalculator {
+	return &taxClassCalculator{taxClassRepository: tr}
+}
+
+func (tc *taxClassCalculator) CalculateTax(input *PricingInput, breakdown *domain.PriceBreakdown) error {
+	// 과세 분류는 가격 계산마다 한 번만 조회해 모든 상품에 사용
+	taxClasses, err := tc.taxClassRepository.GetAll()
+	if err != nil {
+		return err
+	}
+
+	for i, item := range input.Items {
+		taxClass := findTaxClass(taxClasses, item.Categories())
+		if taxClass == nil {
+			continue
+		}
+		line := &breakdown.Items[i]
+		line.TaxAmount = taxClass.CalculateTax(line.BaseAmount - line.DiscountAmount)
+		line.TaxInclusive = taxClass.IsInclusive()
+	}
+	return nil
+}
+
+// findTaxClass 는 가장 가까운 카테고리에 지정된 과세 분류를 찾고, 없으면 기본 분류를 반환합니다.
+func findTaxClass(taxClasses []*domain.TaxClass, categories []string) *domain.TaxClass {
+	for _, category := range categories {
+		for _, candidate := range taxClasses {
+			if candidate.AppliesTo(category) {
+				return candidate
+			}
+		}
+	}
+	for _, candidate := range taxClasses {
+		if candidate.IsDefault {
+			return candidate
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	domainRepository "github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+type countingTaxClassRepository struct {
+	domainRepository.TaxClassRepository
+	calls int
+}
+
+func (r *countingTaxClassRepository) GetAll() ([]*domain.TaxClass, error) {
+	r.calls++
+	return r.TaxClassRepository.GetAll()
+}
+
+func taxBreakdown(input *usecases.PricingInput) *domain.PriceBreakdown {
+	breakdown := &domain.PriceBreakdown{}
+	for _, item := range input.Items {
+		breakdown.Items = append(breakdown.Items, domain.PriceBreakdownItem{
+			ProductNumber: item.Product.ProductNumber,
+			Quantity:      item.Quantity,
+			BaseAmount:    item.Product.Price * int64(item.Quantity),
+		})
+	}
+	return breakdown
+}
+
+func TestTaxClassCalculator_CalculateTax_CategoryAndDefault(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	taxClassRepo := &countingTaxClassRepository{TaxClassRepository: repository.NewTaxClassRepository(db)}
+	_ = taxClassRepo.Create(&domain.TaxClass{Name: "표준세율", Rate: 1000, PriceMode: domain.TaxPriceModeExclusive, IsDefault: true})
+	_ = taxClassRepo.Create(&domain.TaxClass{Name: "식품 경감세율", Rate: 800, PriceMode: domain.TaxPriceModeInclusive, Categories: []string{"food"}})
+	calculator := usecases.NewTaxClassCalculator(taxClassRepo)
+	input := &usecases.PricingInput{Items: []usecases.PricingItem{
+		{Product: &domain.Product{ProductNumber: "P12345", Category: "food", Price: 10800}, Quantity: 1},
+		{Product: &domain.Product{ProductNumber: "P12346", Category: "book", Price: 10000}, Quantity: 1},
+	}}
+	breakdown := taxBreakdown(input)
+
+	// When
+	err := calculator.CalculateTax(input, breakdown)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 800, breakdown.Items[0].TaxAmount)
+	assert.True(t, breakdown.Items[0].TaxInclusive)
+	assert.EqualValues(t, 1000, breakdown.Items[1].TaxAmount)
+	assert.False(t, breakdown.Items[1].TaxInclusive)
+	assert.Equal(t, 1, taxClassRepo.calls)
+}
+
+func TestTaxClassCalculator_CalculateTax_ParentCategory(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	taxClassRepo := repository.NewTaxClassRepository(db)
+	_ = taxClassRepo.Create(&domain.TaxClass{Name: "표준세율", Rate: 1000, PriceMode: domain.TaxPriceModeExclusive, IsDefault: true})
+	_ = taxClassRepo.Create(&domain.TaxClass{Name: "식품 경감세율", Rate: 800, PriceMode: domain.TaxPriceModeInclusive, Categories: []string{"food"}})
+	_ = taxClassRepo.Create(&domain.TaxClass{Name: "주류 세율", Rate: 2000, PriceMode: domain.TaxPriceModeExclusive, Categories: []string{"liquor"}})
+	calculator := usecases.NewTaxClassCalculator(taxClassRepo)
+	input := &usecases.PricingInput{Items: []usecases.PricingItem{
+		{Product: &domain.Product{ProductNumber: "P12345", Category: "snacks", Price: 10800}, Quantity: 1, AncestorCategories: []string{"food"}},
+		{Product: &domain.Product{ProductNumber: "P12346", Category: "liquor", Price: 10000}, Quantity: 1, AncestorCategories: []string{"food"}},
+	}}
+	breakdown := taxBreakdown(input)
+
+	// When
+	err := calculator.CalculateTax(input, breakdown)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 800, breakdown.Items[0].TaxAmount)
+	assert.True(t, breakdown.Items[0].TaxInclusive)
+	assert.EqualValues(t, 2000, breakdown.Items[1].TaxAmount)
+	assert.False(t, breakdown.Items[1].TaxInclusive)
+}
+
+func TestTaxClassCalculator_CalculateTax_NoTaxClass(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	calculator := usecases.NewTaxClassCalculator(repository.NewTaxClassRepository(db))
+	input := &usecases.PricingInput{Items: []usecases.PricingItem{
+		{Product: &domain.Product{ProductNumber: "P12345", Category: "book", Price: 10000}, Quantity: 1},
+	}}
+	breakdown := taxBreakdown(input)
+
+	// When
+	err := calculator.CalculateTax(input, breakdown)
+
+	// Then
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, breakdown.Items[0].TaxAmount)
+	assert.False(t, breakdown.Items[0].TaxInclusive)
+}
@@ -0,0 +1,104 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/HongJungWan/commerce-system/internal/domain"
+	"github.com/HongJungWan/commerce-system/internal/domain/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/response"
+)
+
+type TaxClassInteractor struct {
+	TaxClassRepository repository.TaxClassRepository
+}
+
+func NewTaxClassInteractor(tr repository.TaxClassRepository) *TaxClassInteractor {
+	return &TaxClassInteractor{TaxClassRepository: tr}
+}
+
+func (ti *TaxClassInteractor) CreateTaxClass(req *request.CreateTaxClassRequest) (*response.TaxClassResponse, error) {
+	taxClass, err := req.CreateToEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	if existing, _ := ti.TaxClassRepository.GetByName(taxClass.Name); existing != nil {
+		return nil, errors.New("이미 존재하는 과세 분류명입니다.")
+	}
+	if err := ti.checkConflicts(taxClass); err != nil {
+		return nil, err
+	}
+
+	if err := ti.TaxClassRepository.Create(taxClass); err != nil {
+		return nil, err
+	}
+	return response.NewTaxClassResponse(taxClass), nil
+}
+
+func (ti *TaxClassInteractor) GetTaxClasses() ([]response.TaxClassResponse, error) {
+	taxClasses, err := ti.TaxClassRepository.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	taxClassResponses := make([]response.TaxClassResponse, 0, len(taxClasses))
+	for _, taxClass := range taxClasses {
+		taxClassResponses = append(taxClassResponses, *response.NewTaxClassResponse(taxClass))
+	}
+	return taxClassResponses, nil
+}
+
+func (ti *TaxClassInteractor) GetTaxClass(id int) (*response.TaxClassResponse, error) {
+	taxClass, err := ti.TaxClassRepository.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+	return response.NewTaxClassResponse(taxClass), nil
+}
+
+func (ti *TaxClassInteractor) UpdateTaxClass(id int, req *request.UpdateTaxClassRequest) (*response.TaxClassResponse, error) {
+	taxClass, err := ti.TaxClassRepository.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.ApplyToEntity(taxClass); err != nil {
+		return nil, err
+	}
+	if err := ti.checkConflicts(taxClass); err != nil {
+		return nil, err
+	}
+	if err := ti.TaxClassRepository.Update(taxClass); err != nil {
+		return nil, err
+	}
+	return response.NewTaxClassResponse(taxClass), nil
+}
+
+func (ti *TaxClassInteractor) DeleteTaxClass(id int) error {
+	if _, err := ti.TaxClassRepository.GetById(id); err != nil {
+		return err
+	}
+	return ti.TaxClassRepository.Delete(id)
+}
+
+// 카테고리는 하나의 과세 분류에만 지정할 수 있고, 기본 분류도 하나만 허용
+func (ti *TaxClassInteractor) checkConflicts(taxClass *domain.TaxClass) error {
+	taxClasses, err := ti.TaxClassRepository.GetAll()
+	if err != nil {
+		return err
+	}
+	for _, other := range taxClasses {
+		if other.ID == taxClass.ID {
+			continue
+		}
+		if taxClass.IsDefault && other.IsDefault {
+			return errors.New("기본 과세 분류가 이미 존재합니다.")
+		}
+		for _, category := range taxClass.Categories {
+			if other.AppliesTo(category) {
+				return errors.New("다른 과세 분류에 지정된 카테고리입니다.")
+			}
+		}
+	}
+	return nil
+}
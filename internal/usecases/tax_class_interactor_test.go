@@ -0,0 +1,55 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/HongJungWan/commerce-system/internal/infrastructure/repository"
+	"github.com/HongJungWan/commerce-system/internal/interfaces/dto/request"
+	"github.com/HongJungWan/commerce-system/internal/usecases"
+	"github.com/HongJungWan/commerce-system/test/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxClassInteractor_CreateTaxClass_Success(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewTaxClassInteractor(repository.NewTaxClassRepository(db))
+	req := &request.CreateTaxClassRequest{Name: "식품 경감세율", Rate: 800, PriceMode: "inclusive", Categories: []string{"food"}}
+
+	// When
+	responseData, err := interactor.CreateTaxClass(req)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "식품 경감세율", responseData.Name)
+	assert.Equal(t, "inclusive", responseData.PriceMode)
+}
+
+func TestTaxClassInteractor_CreateTaxClass_Failure_CategoryAssigned(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewTaxClassInteractor(repository.NewTaxClassRepository(db))
+	_, _ = interactor.CreateTaxClass(&request.CreateTaxClassRequest{Name: "식품 경감세율", Rate: 800, PriceMode: "inclusive", Categories: []string{"food"}})
+
+	// When
+	_, err := interactor.CreateTaxClass(&request.CreateTaxClassRequest{Name: "표준세율", Rate: 1000, PriceMode: "exclusive", Categories: []string{"food", "book"}})
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "다른 과세 분류에 지정된 카테고리입니다.", err.Error())
+}
+
+func TestTaxClassInteractor_UpdateTaxClass_Failure_DuplicateDefault(t *testing.T) {
+	// Given
+	db := fixtures.SetupTestDB()
+	interactor := usecases.NewTaxClassInteractor(repository.NewTaxClassRepository(db))
+	_, _ = interactor.CreateTaxClass(&request.CreateTaxClassRequest{Name: "표준세율", Rate: 1000, PriceMode: "exclusive", IsDefault: true})
+	created, _ := interactor.CreateTaxClass(&request.CreateTaxClassRequest{Name: "식품 경감세율", Rate: 800, PriceMode: "inclusive", Categories: []string{"food"}})
+
+	// When
+	_, err := interactor.UpdateTaxClass(created.ID, &request.UpdateTaxClassRequest{Rate: 800, PriceMode: "inclusive", IsDefault: true, Categories: []string{"food"}})
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, "기본 과세 분류가 이미 존재합니다.", err.Error())
+}
@@ -1,6 +1,9 @@
 package fixtures
 
 import (
+	"path/filepath"
+	"testing"
+
 	"github.com/HongJungWan/commerce-system/internal/domain"
 	"github.com/glebarez/sqlite"
 	"gorm.io/gorm"
@@ -13,11 +16,63 @@ func SetupTestDB() *gorm.DB {
 		panic("테스트 데이터베이스 연결에 실패했습니다.")
 	}
 
-	// 스키마 마이그레이션: 필요한 모든 도메인 모델 추가
-	err = db.AutoMigrate(&domain.Member{}, &domain.Product{}, &domain.Order{})
+	// 인메모리 데이터베이스는 커넥션마다 별도로 생성되므로 커넥션을 하나로 고정
+	sqlDB, err := db.DB()
 	if err != nil {
-		panic("테스트 데이터베이스 마이그레이션에 실패했습니다.")
+		panic("테스트 데이터베이스 연결에 실패했습니다.")
 	}
+	sqlDB.SetMaxOpenConns(1)
 
+	migrate(db)
 	return db
 }
+
+// 동시성 테스트용 파일 데이터베이스 설정
+// 인메모리 데이터베이스는 커넥션이 하나뿐이라 트랜잭션이 차례로 실행되므로, WAL 모드의 파일 데이터베이스에 여러 커넥션을 열어
+// 트랜잭션 밖에서 읽은 값으로 재고나 쿠폰 사용 횟수를 덮어쓰는 문제를 드러냅니다.
+// SQLite는 행 잠금(SELECT ... FOR UPDATE)이 없어 쓰기 트랜잭션을 시작할 때 데이터베이스 쓰기 잠금을 잡으므로,
+// 행 잠금 자체의 동작은 MariaDB에서만 확인할 수 있습니다.
+func SetupConcurrentTestDB(t testing.TB) *gorm.DB {
+	dsn := "file:" + filepath.Join(t.TempDir(), "test.db") +
+		"?_pragma=journal_mode(WAL)&_pragma=busy_timeout(10000)&_txlock=immediate"
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("테스트 데이터베이스 연결에 실패했습니다: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("테스트 데이터베이스 연결에 실패했습니다: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(8)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	migrate(db)
+	return db
+}
+
+// 스키마 마이그레이션: 필요한 모든 도메인 모델 추가
+func migrate(db *gorm.DB) {
+	err := db.AutoMigrate(&domain.Member{}, &domain.Address{}, &domain.Category{}, &domain.Product{}, &domain.StockMovement{}, &domain.ProductPriceChange{}, &domain.Order{}, &domain.OrderItem{}, &domain.OrderCancellation{}, &domain.Payment{}, &domain.ReturnRequest{}, &domain.Shipment{}, &domain.Cart{}, &domain.CartItem{}, &domain.Coupon{}, &domain.CouponUsage{}, &domain.TaxClass{}, &domain.IdempotencyKey{})
+	if err != nil {
+		panic("테스트 데이터베이스 마이그레이션에 실패했습니다.")
+	}
+}
+
+// 주문 생성에 필요한 회원의 기본 배송지 등록
+func CreateDefaultAddress(db *gorm.DB, memberNumber string) *domain.Address {
+	address := &domain.Address{
+		MemberNumber: memberNumber,
+		PostalAddress: domain.PostalAddress{
+			RecipientName: "홍길동",
+			Phone:         "010-1234-5678",
+			ZipCode:       "06236",
+			BaseAddress:   "서울특별시 강남구 테헤란로 123",
+		},
+		IsDefault: true,
+	}
+	if err := db.Create(address).Error; err != nil {
+		panic("테스트 배송지 등록에 실패했습니다.")
+	}
+	return address
+}